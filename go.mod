@@ -2,4 +2,8 @@ module github.com/SENERGY-Platform/mgw-module-lib
 
 go 1.22
 
-require golang.org/x/mod v0.20.0
+require (
+	github.com/santhosh-tekuri/jsonschema/v5 v5.3.1
+	golang.org/x/mod v0.20.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,171 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// cpuArchAliases maps alias names, including GOARCH and uname values, to canonical CPU architectures.
+var cpuArchAliases = map[string]CPUArch{
+	X86:      I386,
+	"386":    I386,
+	"i686":   I386,
+	X86_64:   AMD64,
+	AARCH32:  ARM32V7,
+	"armv7l": ARM32V7,
+	"armhf":  ARM32V7,
+	"armv6l": ARM32V6,
+	"armv5l": ARM32V5,
+	"armel":  ARM32V5,
+	AARCH64:  ARM64V8,
+	"arm64":  ARM64V8,
+	"armv8l": ARM32V7,
+	I386:     I386,
+	AMD64:    AMD64,
+	ARM32V5:  ARM32V5,
+	ARM32V6:  ARM32V6,
+	ARM32V7:  ARM32V7,
+	ARM64V8:  ARM64V8,
+}
+
+var cpuArchPlatforms = map[CPUArch]string{
+	I386:    "linux/386",
+	AMD64:   "linux/amd64",
+	ARM32V5: "linux/arm/v5",
+	ARM32V6: "linux/arm/v6",
+	ARM32V7: "linux/arm/v7",
+	ARM64V8: "linux/arm64/v8",
+}
+
+// cpuArchCompat maps canonical host architectures to the architectures they can execute, ordered by preference.
+var cpuArchCompat = map[CPUArch][]CPUArch{
+	I386:    {I386},
+	AMD64:   {AMD64, I386},
+	ARM32V5: {ARM32V5},
+	ARM32V6: {ARM32V6, ARM32V5},
+	ARM32V7: {ARM32V7, ARM32V6, ARM32V5},
+	ARM64V8: {ARM64V8, ARM32V7, ARM32V6, ARM32V5},
+}
+
+// NormalizeCPUArch returns the canonical architecture of s, e.g. x86_64 -> amd64 and aarch64 -> arm64v8.
+func NormalizeCPUArch(s string) (CPUArch, error) {
+	if a, ok := cpuArchAliases[strings.ToLower(s)]; ok {
+		return a, nil
+	}
+	return "", fmt.Errorf("unknown architecture '%s'", s)
+}
+
+// CPUArchPlatform returns the Docker/OCI platform string of an architecture, e.g. arm32v7 -> linux/arm/v7.
+func CPUArchPlatform(s string) (string, error) {
+	a, err := NormalizeCPUArch(s)
+	if err != nil {
+		return "", err
+	}
+	return cpuArchPlatforms[a], nil
+}
+
+// ParsePlatform returns the canonical architecture of a Docker/OCI platform string like linux/arm64 or linux/arm/v6.
+func ParsePlatform(s string) (CPUArch, error) {
+	s = strings.ToLower(s)
+	parts := strings.Split(s, "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] != "linux" {
+		return "", fmt.Errorf("invalid platform '%s'", s)
+	}
+	switch parts[1] {
+	case "arm":
+		if len(parts) == 2 {
+			return ARM32V7, nil
+		}
+	case "arm64":
+		if len(parts) == 2 || parts[2] == "v8" {
+			return ARM64V8, nil
+		}
+		return "", fmt.Errorf("invalid platform '%s'", s)
+	}
+	for a, p := range cpuArchPlatforms {
+		if p == s {
+			return a, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported platform '%s'", s)
+}
+
+// IsCompatibleCPUArch checks if software built for arch can run on host.
+func IsCompatibleCPUArch(arch, host string) (bool, error) {
+	a, err := NormalizeCPUArch(arch)
+	if err != nil {
+		return false, err
+	}
+	h, err := NormalizeCPUArch(host)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range cpuArchCompat[h] {
+		if c == a {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RunsOnHost checks if the module provides a compatible architecture for the host, modules without architectures are not restricted.
+func (m Module) RunsOnHost(host string) (bool, error) {
+	if _, err := NormalizeCPUArch(host); err != nil {
+		return false, err
+	}
+	if len(m.Architectures) == 0 {
+		return true, nil
+	}
+	for arch := range m.Architectures {
+		ok, err := IsCompatibleCPUArch(arch, host)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetImage returns the image of the host architecture, Image or else the image of the most preferred architecture the host can execute.
+func (s Service) GetImage(host string) (string, error) {
+	h, err := NormalizeCPUArch(host)
+	if err != nil {
+		return "", err
+	}
+	images := make(map[CPUArch]string)
+	for arch, img := range s.ArchImages {
+		if a, err := NormalizeCPUArch(arch); err == nil {
+			images[a] = img
+		}
+	}
+	if img, ok := images[h]; ok {
+		return img, nil
+	}
+	if s.Image != "" {
+		return s.Image, nil
+	}
+	for _, a := range cpuArchCompat[h] {
+		if img, ok := images[a]; ok {
+			return img, nil
+		}
+	}
+	return "", fmt.Errorf("no image for architecture '%s'", host)
+}
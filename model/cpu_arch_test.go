@@ -0,0 +1,144 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import "testing"
+
+func TestNormalizeCPUArch(t *testing.T) {
+	a := map[string]CPUArch{
+		X86_64:   AMD64,
+		AMD64:    AMD64,
+		X86:      I386,
+		AARCH64:  ARM64V8,
+		"arm64":  ARM64V8,
+		AARCH32:  ARM32V7,
+		"armv6l": ARM32V6,
+		ARM32V5:  ARM32V5,
+	}
+	for s, c := range a {
+		if b, err := NormalizeCPUArch(s); err != nil {
+			t.Errorf("NormalizeCPUArch(\"%s\"); err != nil", s)
+		} else if b != c {
+			t.Errorf("%s != %s", b, c)
+		}
+	}
+	for arch := range CPUArchMap {
+		if _, err := NormalizeCPUArch(arch); err != nil {
+			t.Errorf("NormalizeCPUArch(\"%s\"); err != nil", arch)
+		}
+	}
+	if _, err := NormalizeCPUArch("test"); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestCPUArchPlatform(t *testing.T) {
+	a := map[string]string{
+		X86_64:  "linux/amd64",
+		ARM32V7: "linux/arm/v7",
+		AARCH64: "linux/arm64/v8",
+		X86:     "linux/386",
+	}
+	for s, p := range a {
+		if b, err := CPUArchPlatform(s); err != nil {
+			t.Errorf("CPUArchPlatform(\"%s\"); err != nil", s)
+		} else if b != p {
+			t.Errorf("%s != %s", b, p)
+		}
+	}
+	if _, err := CPUArchPlatform("test"); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestParsePlatform(t *testing.T) {
+	a := map[string]CPUArch{
+		"linux/amd64":    AMD64,
+		"linux/arm/v6":   ARM32V6,
+		"linux/arm":      ARM32V7,
+		"linux/arm64":    ARM64V8,
+		"linux/arm64/v8": ARM64V8,
+		"Linux/386":      I386,
+	}
+	for s, c := range a {
+		if b, err := ParsePlatform(s); err != nil {
+			t.Errorf("ParsePlatform(\"%s\"); err != nil", s)
+		} else if b != c {
+			t.Errorf("%s != %s", b, c)
+		}
+	}
+	for _, s := range []string{"windows/amd64", "linux", "linux/arm/v8", "linux/arm64/v9", "linux/test"} {
+		if _, err := ParsePlatform(s); err == nil {
+			t.Errorf("ParsePlatform(\"%s\"); err == nil", s)
+		}
+	}
+}
+
+func TestModule_RunsOnHost(t *testing.T) {
+	m := Module{Architectures: Set[CPUArch]{ARM32V7: {}, X86_64: {}}}
+	ok := []string{AMD64, "x86_64", ARM64V8, "armv7l"}
+	notOk := []string{I386, ARM32V6}
+	for _, h := range ok {
+		if b, err := m.RunsOnHost(h); err != nil || !b {
+			t.Errorf("m.RunsOnHost(\"%s\"); err != nil || !b", h)
+		}
+	}
+	for _, h := range notOk {
+		if b, err := m.RunsOnHost(h); err != nil || b {
+			t.Errorf("m.RunsOnHost(\"%s\"); err != nil || b", h)
+		}
+	}
+	if _, err := m.RunsOnHost("test"); err == nil {
+		t.Error("err == nil")
+	}
+	if b, err := (Module{}).RunsOnHost(I386); err != nil || !b {
+		t.Error("err != nil || !b")
+	}
+}
+
+func TestService_GetImage(t *testing.T) {
+	s := Service{
+		Image:      "test",
+		ArchImages: map[CPUArch]string{X86_64: "test-amd64", ARM32V6: "test-arm32v6", AARCH64: "test-arm64"},
+	}
+	a := map[string]string{
+		AMD64:   "test-amd64",
+		ARM64V8: "test-arm64",
+		ARM32V7: "test",
+		ARM32V5: "test",
+		I386:    "test",
+	}
+	for h, img := range a {
+		if b, err := s.GetImage(h); err != nil {
+			t.Errorf("s.GetImage(\"%s\"); err != nil", h)
+		} else if b != img {
+			t.Errorf("%s != %s", b, img)
+		}
+	}
+	if _, err := s.GetImage("test"); err == nil {
+		t.Error("err == nil")
+	}
+	s.Image = ""
+	if b, err := s.GetImage(ARM32V7); err != nil {
+		t.Errorf("s.GetImage(\"%s\"); err != nil", ARM32V7)
+	} else if b != "test-arm32v6" {
+		t.Errorf("%s != test-arm32v6", b)
+	}
+	if _, err := s.GetImage(I386); err == nil {
+		t.Error("err == nil")
+	}
+}
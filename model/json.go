@@ -0,0 +1,146 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type configValueAlias ConfigValue
+
+type configTypeOptionAlias ConfigTypeOption
+
+func (v *ConfigValue) UnmarshalJSON(b []byte) error {
+	var aux struct {
+		configValueAlias
+		Default json.RawMessage `json:"default"`
+		Options json.RawMessage `json:"options"`
+	}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	cv := ConfigValue(aux.configValueAlias)
+	var err error
+	if cv.Default, err = unmarshalTypedValue(aux.Default, cv.DataType, cv.IsSlice); err != nil {
+		return fmt.Errorf("default %s", err)
+	}
+	if cv.Options, err = unmarshalTypedValue(aux.Options, cv.DataType, true); err != nil {
+		return fmt.Errorf("options %s", err)
+	}
+	*v = cv
+	return nil
+}
+
+func (v ConfigValue) MarshalJSON() ([]byte, error) {
+	if err := checkValueType(v.Default, v.DataType, v.IsSlice); err != nil {
+		return nil, fmt.Errorf("default %s", err)
+	}
+	if err := checkValueType(v.Options, v.DataType, true); err != nil {
+		return nil, fmt.Errorf("options %s", err)
+	}
+	return json.Marshal(configValueAlias(v))
+}
+
+func (o *ConfigTypeOption) UnmarshalJSON(b []byte) error {
+	var aux struct {
+		configTypeOptionAlias
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	cto := ConfigTypeOption(aux.configTypeOptionAlias)
+	var err error
+	if cto.Value, err = unmarshalTypedValue(aux.Value, cto.DataType, false); err != nil {
+		return fmt.Errorf("value %s", err)
+	}
+	*o = cto
+	return nil
+}
+
+func (o ConfigTypeOption) MarshalJSON() ([]byte, error) {
+	if err := checkValueType(o.Value, o.DataType, false); err != nil {
+		return nil, fmt.Errorf("value %s", err)
+	}
+	return json.Marshal(configTypeOptionAlias(o))
+}
+
+func unmarshalTypedValue(b json.RawMessage, dataType DataType, isSlice bool) (any, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+	switch dataType {
+	case StringType:
+		return unmarshalValue[string](b, isSlice)
+	case BoolType:
+		return unmarshalValue[bool](b, isSlice)
+	case Int64Type:
+		return unmarshalValue[int64](b, isSlice)
+	case Float64Type:
+		return unmarshalValue[float64](b, isSlice)
+	default:
+		return nil, fmt.Errorf("invalid data type '%s'", dataType)
+	}
+}
+
+func unmarshalValue[T any](b json.RawMessage, isSlice bool) (any, error) {
+	if isSlice {
+		var sl []T
+		if err := json.Unmarshal(b, &sl); err != nil {
+			return nil, err
+		}
+		return sl, nil
+	}
+	var v T
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func checkValueType(v any, dataType DataType, isSlice bool) error {
+	if v == nil {
+		return nil
+	}
+	var ok bool
+	switch dataType {
+	case StringType:
+		ok = isType[string](v, isSlice)
+	case BoolType:
+		ok = isType[bool](v, isSlice)
+	case Int64Type:
+		ok = isType[int64](v, isSlice)
+	case Float64Type:
+		ok = isType[float64](v, isSlice)
+	default:
+		return fmt.Errorf("invalid data type '%s'", dataType)
+	}
+	if !ok {
+		return fmt.Errorf("invalid type '%T' for data type '%s'", v, dataType)
+	}
+	return nil
+}
+
+func isType[T any](v any, isSlice bool) (ok bool) {
+	if isSlice {
+		_, ok = v.([]T)
+	} else {
+		_, ok = v.(T)
+	}
+	return
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestConfigs_JSON(t *testing.T) {
+	str := "test"
+	i := int64(1)
+	f := 1.5
+	b := true
+	cto := make(ConfigTypeOptions)
+	cto.SetInt64("min", 0)
+	cto.SetFloat64("step", 0.5)
+	cto.SetString("regex", str)
+	cto.SetBool("test", b)
+	a := make(Configs)
+	a.SetString("a", &str, []string{str}, false, str, nil, false)
+	a.SetBool("b", &b, nil, false, "", nil, false)
+	a.SetInt64("c", &i, []int64{i, 2}, false, str, cto, true)
+	a.SetFloat64("d", &f, []float64{f}, true, "", nil, false)
+	a.SetStringSlice("e", []string{str}, nil, false, "", nil, ",", false)
+	a.SetBoolSlice("f", []bool{b}, nil, false, "", nil, ",", false)
+	a.SetInt64Slice("g", []int64{i}, []int64{i, 2}, false, "", cto, ",", false)
+	a.SetFloat64Slice("h", nil, []float64{f}, false, "", nil, ",", false)
+	a.SetString("i", nil, nil, false, "", nil, false)
+	p, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var c Configs
+	if err = json.Unmarshal(p, &c); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(a, c) {
+		t.Errorf("%v != %v", a, c)
+	}
+	if _, ok := c["c"].Default.(int64); !ok {
+		t.Errorf("%T != int64", c["c"].Default)
+	}
+	if _, ok := c["g"].Options.([]int64); !ok {
+		t.Errorf("%T != []int64", c["g"].Options)
+	}
+	if _, ok := c["c"].TypeOpt["min"].Value.(int64); !ok {
+		t.Errorf("%T != int64", c["c"].TypeOpt["min"].Value)
+	}
+}
+
+func TestConfigValue_UnmarshalJSON(t *testing.T) {
+	var cv ConfigValue
+	if err := json.Unmarshal([]byte(`{"default":1,"options":[1,2],"data_type":"float"}`), &cv); err != nil {
+		t.Error("err != nil")
+	}
+	if _, ok := cv.Default.(float64); !ok {
+		t.Errorf("%T != float64", cv.Default)
+	}
+	if _, ok := cv.Options.([]float64); !ok {
+		t.Errorf("%T != []float64", cv.Options)
+	}
+	if err := json.Unmarshal([]byte(`{"default":"test","data_type":"int"}`), &cv); err == nil {
+		t.Error("err == nil")
+	}
+	if err := json.Unmarshal([]byte(`{"default":1,"data_type":"int","is_slice":true}`), &cv); err == nil {
+		t.Error("err == nil")
+	}
+	if err := json.Unmarshal([]byte(`{"default":1,"data_type":"test"}`), &cv); err == nil {
+		t.Error("err == nil")
+	}
+	if err := json.Unmarshal([]byte(`{"type_opt":{"min":{"value":"test","data_type":"int"}},"data_type":"int"}`), &cv); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestConfigValue_MarshalJSON(t *testing.T) {
+	cv := ConfigValue{Default: 1, DataType: Int64Type}
+	if _, err := json.Marshal(cv); err == nil {
+		t.Error("err == nil")
+	}
+	cv = ConfigValue{Options: []string{"test"}, DataType: Int64Type}
+	if _, err := json.Marshal(cv); err == nil {
+		t.Error("err == nil")
+	}
+	cto := ConfigTypeOption{Value: "test", DataType: Int64Type}
+	if _, err := json.Marshal(cto); err == nil {
+		t.Error("err == nil")
+	}
+}
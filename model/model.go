@@ -48,6 +48,7 @@ type Module struct {
 type Service struct {
 	Name              string                         `json:"name"`
 	Image             string                         `json:"image"`
+	ArchImages        map[CPUArch]string             `json:"arch_images"` // {cpuArch:image}
 	RunConfig         RunConfig                      `json:"run_config"`
 	BindMounts        map[string]BindMount           `json:"bind_mounts"`      // {mntPoint:BindMount}
 	Tmpfs             map[string]TmpfsMount          `json:"tmpfs"`            // {mntPoint:TmpfsMount}
@@ -76,6 +77,30 @@ type AuxService struct {
 	ExtDependencies map[string]ExtDependencyTarget `json:"ext_dependencies"` // {refVar:ExtDependencyTarget}
 }
 
+// AuxDeploymentRequest describes an aux service to be deployed, maps are merged with the aux service and set RunConfig fields override its run config.
+type AuxDeploymentRequest struct {
+	Ref             string                         `json:"ref"` // aux service reference
+	Image           string                         `json:"image"`
+	RunConfig       *RunConfig                     `json:"run_config"`
+	Volumes         map[string]string              `json:"volumes"`          // {mntPoint:volName}
+	Configs         map[string]string              `json:"configs"`          // {refVar:ref}
+	SrvReferences   map[string]SrvRefTarget        `json:"srv_references"`   // {refVar:SrvRefTarget}
+	ExtDependencies map[string]ExtDependencyTarget `json:"ext_dependencies"` // {refVar:ExtDependencyTarget}
+}
+
+type AuxContainerSpec struct {
+	Ref             string                         `json:"ref"`
+	Name            string                         `json:"name"`
+	Image           string                         `json:"image"`
+	RunConfig       RunConfig                      `json:"run_config"`
+	BindMounts      map[string]BindMount           `json:"bind_mounts"`      // {mntPoint:BindMount}
+	Tmpfs           map[string]TmpfsMount          `json:"tmpfs"`            // {mntPoint:TmpfsMount}
+	Volumes         map[string]string              `json:"volumes"`          // {mntPoint:volName}
+	Configs         map[string]string              `json:"configs"`          // {refVar:ref}
+	SrvReferences   map[string]SrvRefTarget        `json:"srv_references"`   // {refVar:SrvRefTarget}
+	ExtDependencies map[string]ExtDependencyTarget `json:"ext_dependencies"` // {refVar:ExtDependencyTarget}
+}
+
 type RunConfig struct {
 	StopTimeout time.Duration `json:"stop_timeout"`
 	StopSignal  string        `json:"stop_signal"`
@@ -0,0 +1,387 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modfile
+
+import (
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"gopkg.in/yaml.v3"
+)
+
+func (mf modFile) genModule() (model.Module, error) {
+	m := model.Module{
+		ID:            mf.ID,
+		Name:          mf.Name,
+		Description:   mf.Description,
+		Tags:          genSet(mf.Tags),
+		License:       mf.License,
+		Author:        mf.Author,
+		Version:       mf.Version,
+		Architectures: genSet(mf.Architectures),
+		Volumes:       genSet(mf.Volumes),
+		Dependencies:  mf.Dependencies,
+		AuxImgSrc:     genSet(mf.AuxImageSources),
+	}
+	if len(mf.Services) > 0 {
+		m.Services = make(map[string]model.Service)
+		for ref, srv := range mf.Services {
+			m.Services[ref] = srv.genService()
+		}
+	}
+	if len(mf.AuxServices) > 0 {
+		m.AuxServices = make(map[string]model.AuxService)
+		for ref, srv := range mf.AuxServices {
+			m.AuxServices[ref] = srv.genAuxService()
+		}
+	}
+	if len(mf.HostResources) > 0 {
+		m.HostResources = make(map[string]model.HostResource)
+		for ref, res := range mf.HostResources {
+			m.HostResources[ref] = model.HostResource{
+				Resource: model.Resource{
+					Tags:     genSet(res.Tags),
+					Required: res.Required,
+				},
+			}
+			m.Inputs.Resources = addInput(m.Inputs.Resources, ref, res.UserInput)
+		}
+	}
+	if len(mf.Secrets) > 0 {
+		m.Secrets = make(map[string]model.Secret)
+		for ref, sec := range mf.Secrets {
+			m.Secrets[ref] = model.Secret{
+				Resource: model.Resource{
+					Tags:     genSet(sec.Tags),
+					Required: sec.Required,
+				},
+				Type: sec.Type,
+			}
+			m.Inputs.Secrets = addInput(m.Inputs.Secrets, ref, sec.UserInput)
+		}
+	}
+	if len(mf.Files) > 0 {
+		m.Files = make(map[string]model.File)
+		for ref, f := range mf.Files {
+			m.Files[ref] = model.File{
+				Source:   f.Source,
+				Type:     f.Type,
+				Required: f.Required,
+			}
+			m.Inputs.Files = addInput(m.Inputs.Files, ref, f.UserInput)
+		}
+	}
+	if len(mf.FileGroups) > 0 {
+		m.FileGroups = make(model.Set[string])
+		for ref, fg := range mf.FileGroups {
+			m.FileGroups[ref] = struct{}{}
+			m.Inputs.FileGroups = addInput(m.Inputs.FileGroups, ref, fg.UserInput)
+		}
+	}
+	if len(mf.Configs) > 0 {
+		m.Configs = make(model.Configs)
+		for ref, cv := range mf.Configs {
+			if err := setConfig(m.Configs, ref, cv); err != nil {
+				return model.Module{}, fmt.Errorf("config '%s' %s", ref, err)
+			}
+			m.Inputs.Configs = addInput(m.Inputs.Configs, ref, cv.UserInput)
+		}
+	}
+	if len(mf.InputGroups) > 0 {
+		m.Inputs.Groups = make(map[string]model.InputGroup)
+		for ref, group := range mf.InputGroups {
+			m.Inputs.Groups[ref] = model.InputGroup{
+				Name:        group.Name,
+				Description: group.Description,
+				Group:       group.Group,
+			}
+		}
+	}
+	return m, nil
+}
+
+func (s service) genService() model.Service {
+	srv := model.Service{
+		Name:              s.Name,
+		Image:             s.Image,
+		ArchImages:        s.ArchImages,
+		RunConfig:         s.RunConfig.genRunConfig(),
+		Volumes:           s.Volumes,
+		Files:             s.Files,
+		FileGroups:        s.FileGroups,
+		Configs:           s.Configs,
+		DeviceCGroupRules: s.DeviceCGroupRules,
+	}
+	srv.BindMounts = genMap(s.BindMounts, bindMount.genBindMount)
+	srv.Tmpfs = genMap(s.Tmpfs, tmpfsMount.genTmpfsMount)
+	srv.HostResources = genMap(s.HostResources, hostResTarget.genHostResTarget)
+	srv.SecretMounts = genMap(s.SecretMounts, secretTarget.genSecretTarget)
+	srv.SecretVars = genMap(s.SecretVars, secretTarget.genSecretTarget)
+	srv.SrvReferences = genMap(s.SrvReferences, srvRefTarget.genSrvRefTarget)
+	srv.HttpEndpoints = genMap(s.HttpEndpoints, httpEndpoint.genHttpEndpoint)
+	srv.ExtDependencies = genMap(s.ExtDependencies, extDependencyTarget.genExtDependencyTarget)
+	for _, p := range s.Ports {
+		srv.Ports = append(srv.Ports, model.Port{
+			Name:     p.Name,
+			Number:   p.Number,
+			Protocol: p.Protocol,
+			Bindings: p.Bindings,
+		})
+	}
+	return srv
+}
+
+func (s auxService) genAuxService() model.AuxService {
+	return model.AuxService{
+		Name:            s.Name,
+		RunConfig:       s.RunConfig.genRunConfig(),
+		BindMounts:      genMap(s.BindMounts, bindMount.genBindMount),
+		Tmpfs:           genMap(s.Tmpfs, tmpfsMount.genTmpfsMount),
+		Volumes:         s.Volumes,
+		Configs:         s.Configs,
+		SrvReferences:   genMap(s.SrvReferences, srvRefTarget.genSrvRefTarget),
+		ExtDependencies: genMap(s.ExtDependencies, extDependencyTarget.genExtDependencyTarget),
+	}
+}
+
+func (c runConfig) genRunConfig() model.RunConfig {
+	return model.RunConfig{
+		StopTimeout: time.Duration(c.StopTimeout),
+		StopSignal:  c.StopSignal,
+		PseudoTTY:   c.PseudoTTY,
+		Command:     c.Command,
+	}
+}
+
+func (m bindMount) genBindMount() model.BindMount {
+	return model.BindMount{
+		Source:   m.Source,
+		ReadOnly: m.ReadOnly,
+	}
+}
+
+func (m tmpfsMount) genTmpfsMount() model.TmpfsMount {
+	return model.TmpfsMount{
+		Size: m.Size,
+		Mode: fs.FileMode(m.Mode),
+	}
+}
+
+func (t hostResTarget) genHostResTarget() model.HostResTarget {
+	return model.HostResTarget{
+		Ref:      t.Ref,
+		ReadOnly: t.ReadOnly,
+	}
+}
+
+func (t secretTarget) genSecretTarget() model.SecretTarget {
+	return model.SecretTarget{
+		Ref:  t.Ref,
+		Item: t.Item,
+	}
+}
+
+func (t srvRefTarget) genSrvRefTarget() model.SrvRefTarget {
+	return model.SrvRefTarget{
+		Ref:      t.Ref,
+		Template: t.Template,
+	}
+}
+
+func (t extDependencyTarget) genExtDependencyTarget() model.ExtDependencyTarget {
+	return model.ExtDependencyTarget{
+		ID:       t.ID,
+		Service:  t.Service,
+		Template: t.Template,
+	}
+}
+
+func (e httpEndpoint) genHttpEndpoint() model.HttpEndpoint {
+	return model.HttpEndpoint{
+		Name: e.Name,
+		Port: e.Port,
+		Path: e.Path,
+		ProxyConf: model.HttpEndpointProxyConf{
+			Headers:     e.ProxyConf.Headers,
+			WebSocket:   e.ProxyConf.WebSocket,
+			ReadTimeout: time.Duration(e.ProxyConf.ReadTimeout),
+		},
+		StringSub: model.HttpEndpointStrSub{
+			ReplaceOnce: e.StringSub.ReplaceOnce,
+			MimeTypes:   e.StringSub.MimeTypes,
+			Filters:     e.StringSub.Filters,
+		},
+	}
+}
+
+func setConfig(configs model.Configs, ref string, cv configValue) error {
+	cTypeOpt, err := genConfigTypeOptions(cv.TypeOptions, cv.DataType)
+	if err != nil {
+		return err
+	}
+	switch cv.DataType {
+	case model.StringType:
+		if cv.IsList {
+			return setConfigSlice(configs.SetStringSlice, ref, cv, cTypeOpt)
+		}
+		return setConfigValue(configs.SetString, ref, cv, cTypeOpt)
+	case model.BoolType:
+		if cv.IsList {
+			return setConfigSlice(configs.SetBoolSlice, ref, cv, cTypeOpt)
+		}
+		return setConfigValue(configs.SetBool, ref, cv, cTypeOpt)
+	case model.Int64Type:
+		if cv.IsList {
+			return setConfigSlice(configs.SetInt64Slice, ref, cv, cTypeOpt)
+		}
+		return setConfigValue(configs.SetInt64, ref, cv, cTypeOpt)
+	case model.Float64Type:
+		if cv.IsList {
+			return setConfigSlice(configs.SetFloat64Slice, ref, cv, cTypeOpt)
+		}
+		return setConfigValue(configs.SetFloat64, ref, cv, cTypeOpt)
+	default:
+		return fmt.Errorf("invalid data type '%s'", cv.DataType)
+	}
+}
+
+func setConfigValue[T any](setFunc func(string, *T, []T, bool, string, model.ConfigTypeOptions, bool), ref string, cv configValue, cTypeOpt model.ConfigTypeOptions) error {
+	var def *T
+	if !isEmptyNode(cv.Value) {
+		var v T
+		if err := cv.Value.Decode(&v); err != nil {
+			return fmt.Errorf("invalid value: %s", err)
+		}
+		def = &v
+	}
+	opt, err := decodeSlice[T](cv.Options)
+	if err != nil {
+		return fmt.Errorf("invalid options: %s", err)
+	}
+	setFunc(ref, def, opt, cv.OptionsExt, cv.Type, cTypeOpt, cv.Required)
+	return nil
+}
+
+func setConfigSlice[T any](setFunc func(string, []T, []T, bool, string, model.ConfigTypeOptions, string, bool), ref string, cv configValue, cTypeOpt model.ConfigTypeOptions) error {
+	def, err := decodeSlice[T](cv.Value)
+	if err != nil {
+		return fmt.Errorf("invalid value: %s", err)
+	}
+	opt, err := decodeSlice[T](cv.Options)
+	if err != nil {
+		return fmt.Errorf("invalid options: %s", err)
+	}
+	setFunc(ref, def, opt, cv.OptionsExt, cv.Type, cTypeOpt, cv.Delimiter, cv.Required)
+	return nil
+}
+
+func genConfigTypeOptions(typeOptions map[string]yaml.Node, dataType model.DataType) (model.ConfigTypeOptions, error) {
+	if len(typeOptions) == 0 {
+		return nil, nil
+	}
+	cTypeOpt := make(model.ConfigTypeOptions)
+	for name, node := range typeOptions {
+		var err error
+		switch node.Tag {
+		case "!!str":
+			cTypeOpt.SetString(name, node.Value)
+		case "!!bool":
+			var v bool
+			if err = node.Decode(&v); err == nil {
+				cTypeOpt.SetBool(name, v)
+			}
+		case "!!int":
+			// number options of float configs inherit the config data type
+			if dataType == model.Float64Type {
+				var v float64
+				if err = node.Decode(&v); err == nil {
+					cTypeOpt.SetFloat64(name, v)
+				}
+			} else {
+				var v int64
+				if err = node.Decode(&v); err == nil {
+					cTypeOpt.SetInt64(name, v)
+				}
+			}
+		case "!!float":
+			var v float64
+			if err = node.Decode(&v); err == nil {
+				cTypeOpt.SetFloat64(name, v)
+			}
+		default:
+			return nil, fmt.Errorf("type option '%s' invalid value '%s'", name, node.Value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("type option '%s' %s", name, err)
+		}
+	}
+	return cTypeOpt, nil
+}
+
+func decodeSlice[T any](node yaml.Node) ([]T, error) {
+	if isEmptyNode(node) {
+		return nil, nil
+	}
+	var sl []T
+	if err := node.Decode(&sl); err != nil {
+		return nil, err
+	}
+	return sl, nil
+}
+
+func isEmptyNode(node yaml.Node) bool {
+	return node.Kind == 0 || node.Tag == "!!null"
+}
+
+func addInput(inputs map[string]model.Input, ref string, ui *userInput) map[string]model.Input {
+	if ui == nil {
+		return inputs
+	}
+	if inputs == nil {
+		inputs = make(map[string]model.Input)
+	}
+	inputs[ref] = model.Input{
+		Name:        ui.Name,
+		Description: ui.Description,
+		Group:       ui.Group,
+	}
+	return inputs
+}
+
+func genSet(sl []string) model.Set[string] {
+	if len(sl) == 0 {
+		return nil
+	}
+	set := make(model.Set[string])
+	for _, item := range sl {
+		set[item] = struct{}{}
+	}
+	return set
+}
+
+func genMap[A any, B any](m map[string]A, genFunc func(A) B) map[string]B {
+	if len(m) == 0 {
+		return nil
+	}
+	nm := make(map[string]B)
+	for key, val := range m {
+		nm[key] = genFunc(val)
+	}
+	return nm
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modfile
+
+import (
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"gopkg.in/yaml.v3"
+)
+
+type modFile struct {
+	ID              string                  `yaml:"id"`
+	Name            string                  `yaml:"name"`
+	Description     string                  `yaml:"description"`
+	Tags            []string                `yaml:"tags"`
+	License         string                  `yaml:"license"`
+	Author          string                  `yaml:"author"`
+	Version         string                  `yaml:"version"`
+	Architectures   []string                `yaml:"architectures"`
+	Services        map[string]service      `yaml:"services"`        // {ref:service}
+	Volumes         []string                `yaml:"volumes"`         // [volName]
+	Dependencies    map[string]string       `yaml:"dependencies"`    // {moduleID:moduleVersion}
+	HostResources   map[string]hostResource `yaml:"hostResources"`   // {ref:hostResource}
+	Secrets         map[string]secret       `yaml:"secrets"`         // {ref:secret}
+	Files           map[string]file         `yaml:"files"`           // {ref:file}
+	FileGroups      map[string]fileGroup    `yaml:"fileGroups"`      // {ref:fileGroup}
+	Configs         map[string]configValue  `yaml:"configs"`         // {ref:configValue}
+	AuxServices     map[string]auxService   `yaml:"auxServices"`     // {ref:auxService}
+	AuxImageSources []string                `yaml:"auxImageSources"` // [imgSrc]
+	InputGroups     map[string]inputGroup   `yaml:"inputGroups"`     // {ref:inputGroup}
+}
+
+type service struct {
+	Name              string                         `yaml:"name"`
+	Image             string                         `yaml:"image"`
+	ArchImages        map[string]string              `yaml:"archImages"` // {cpuArch:image}
+	RunConfig         runConfig                      `yaml:"runConfig"`
+	BindMounts        map[string]bindMount           `yaml:"bindMounts"`      // {mntPoint:bindMount}
+	Tmpfs             map[string]tmpfsMount          `yaml:"tmpfs"`           // {mntPoint:tmpfsMount}
+	Volumes           map[string]string              `yaml:"volumes"`         // {mntPoint:volName}
+	HostResources     map[string]hostResTarget       `yaml:"hostResources"`   // {mntPoint:hostResTarget}
+	SecretMounts      map[string]secretTarget        `yaml:"secretMounts"`    // {mntPoint:secretTarget}
+	SecretVars        map[string]secretTarget        `yaml:"secretVars"`      // {refVar:secretTarget}
+	Files             map[string]string              `yaml:"files"`           // {mntPoint:ref}
+	FileGroups        map[string]string              `yaml:"fileGroups"`      // {basePath:ref}
+	Configs           map[string]string              `yaml:"configs"`         // {refVar:ref}
+	SrvReferences     map[string]srvRefTarget        `yaml:"srvReferences"`   // {refVar:srvRefTarget}
+	HttpEndpoints     map[string]httpEndpoint        `yaml:"httpEndpoints"`   // {externalPath:httpEndpoint}
+	ExtDependencies   map[string]extDependencyTarget `yaml:"extDependencies"` // {refVar:extDependencyTarget}
+	Ports             []port                         `yaml:"ports"`
+	DeviceCGroupRules []string                       `yaml:"deviceCGroupRules"`
+}
+
+type auxService struct {
+	Name            string                         `yaml:"name"`
+	RunConfig       runConfig                      `yaml:"runConfig"`
+	BindMounts      map[string]bindMount           `yaml:"bindMounts"`      // {mntPoint:bindMount}
+	Tmpfs           map[string]tmpfsMount          `yaml:"tmpfs"`           // {mntPoint:tmpfsMount}
+	Volumes         map[string]string              `yaml:"volumes"`         // {mntPoint:volName}
+	Configs         map[string]string              `yaml:"configs"`         // {refVar:ref}
+	SrvReferences   map[string]srvRefTarget        `yaml:"srvReferences"`   // {refVar:srvRefTarget}
+	ExtDependencies map[string]extDependencyTarget `yaml:"extDependencies"` // {refVar:extDependencyTarget}
+}
+
+type runConfig struct {
+	StopTimeout duration `yaml:"stopTimeout"`
+	StopSignal  string   `yaml:"stopSignal"`
+	PseudoTTY   bool     `yaml:"pseudoTTY"`
+	Command     []string `yaml:"command"`
+}
+
+type bindMount struct {
+	Source   string `yaml:"source"`
+	ReadOnly bool   `yaml:"readOnly"`
+}
+
+type tmpfsMount struct {
+	Size int64    `yaml:"size"`
+	Mode fileMode `yaml:"mode"`
+}
+
+type httpEndpoint struct {
+	Name      string        `yaml:"name"`
+	Port      int           `yaml:"port"`
+	Path      string        `yaml:"path"`
+	ProxyConf proxyConf     `yaml:"proxyConf"`
+	StringSub stringSubConf `yaml:"stringSub"`
+}
+
+type stringSubConf struct {
+	ReplaceOnce bool              `yaml:"replaceOnce"`
+	MimeTypes   []string          `yaml:"mimeTypes"`
+	Filters     map[string]string `yaml:"filters"`
+}
+
+type proxyConf struct {
+	Headers     map[string]string `yaml:"headers"`
+	WebSocket   bool              `yaml:"webSocket"`
+	ReadTimeout duration          `yaml:"readTimeout"`
+}
+
+type port struct {
+	Name     string `yaml:"name"`
+	Number   int    `yaml:"number"`
+	Protocol string `yaml:"protocol"`
+	Bindings []int  `yaml:"bindings"`
+}
+
+type extDependencyTarget struct {
+	ID       string `yaml:"id"`
+	Service  string `yaml:"service"`
+	Template string `yaml:"template"`
+}
+
+type hostResTarget struct {
+	Ref      string `yaml:"ref"`
+	ReadOnly bool   `yaml:"readOnly"`
+}
+
+type secretTarget struct {
+	Ref  string `yaml:"ref"`
+	Item string `yaml:"item"`
+}
+
+type srvRefTarget struct {
+	Ref      string `yaml:"ref"`
+	Template string `yaml:"template"`
+}
+
+type hostResource struct {
+	Tags      []string   `yaml:"tags"`
+	Required  bool       `yaml:"required"`
+	UserInput *userInput `yaml:"userInput"`
+}
+
+type secret struct {
+	Type      string     `yaml:"type"`
+	Tags      []string   `yaml:"tags"`
+	Required  bool       `yaml:"required"`
+	UserInput *userInput `yaml:"userInput"`
+}
+
+type file struct {
+	Source    string     `yaml:"source"`
+	Type      string     `yaml:"type"`
+	Required  bool       `yaml:"required"`
+	UserInput *userInput `yaml:"userInput"`
+}
+
+type fileGroup struct {
+	UserInput *userInput `yaml:"userInput"`
+}
+
+type configValue struct {
+	DataType    model.DataType       `yaml:"dataType"`
+	IsList      bool                 `yaml:"isList"`
+	Value       yaml.Node            `yaml:"value"`
+	Options     yaml.Node            `yaml:"options"`
+	OptionsExt  bool                 `yaml:"optionsExt"`
+	Type        string               `yaml:"type"`
+	TypeOptions map[string]yaml.Node `yaml:"typeOptions"`
+	Delimiter   string               `yaml:"delimiter"`
+	Required    bool                 `yaml:"required"`
+	UserInput   *userInput           `yaml:"userInput"`
+}
+
+type userInput struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Group       string `yaml:"group"`
+}
+
+type inputGroup struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Group       string `yaml:"group"`
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modfile
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"gopkg.in/yaml.v3"
+)
+
+const CurrentVersion = "v2"
+
+// upgraders transform the document of a schema version in place and return the next version.
+var upgraders = map[string]func(node *yaml.Node) (string, error){
+	"v1": upgradeV1,
+}
+
+type header struct {
+	Version string `yaml:"modfileVersion"`
+}
+
+// Decode reads a YAML or JSON modfile, upgrades it to the current schema version and generates a module.
+func Decode(r io.Reader) (model.Module, error) {
+	var node yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&node); err != nil {
+		if errors.Is(err, io.EOF) {
+			return model.Module{}, errors.New("empty modfile")
+		}
+		return model.Module{}, err
+	}
+	return decode(&node)
+}
+
+func DecodeFile(path string) (model.Module, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return model.Module{}, err
+	}
+	defer file.Close()
+	return Decode(file)
+}
+
+func decode(node *yaml.Node) (model.Module, error) {
+	var h header
+	if err := node.Decode(&h); err != nil {
+		return model.Module{}, err
+	}
+	if h.Version == "" {
+		return model.Module{}, errors.New("missing modfile version")
+	}
+	if err := upgrade(node, h.Version); err != nil {
+		return model.Module{}, err
+	}
+	var mf modFile
+	if err := node.Decode(&mf); err != nil {
+		return model.Module{}, err
+	}
+	return mf.genModule()
+}
+
+func upgrade(node *yaml.Node, version string) error {
+	for version != CurrentVersion {
+		upFunc, ok := upgraders[version]
+		if !ok {
+			return fmt.Errorf("modfile version '%s' not supported", version)
+		}
+		v, err := upFunc(node)
+		if err != nil {
+			return fmt.Errorf("upgrading modfile version '%s' failed: %s", version, err)
+		}
+		version = v
+	}
+	return nil
+}
+
+// upgradeV1 renames the 'include' key of services to 'bindMounts'.
+func upgradeV1(node *yaml.Node) (string, error) {
+	services, err := getMappingValue(node, "services")
+	if err != nil || services == nil {
+		return "v2", err
+	}
+	for i := 1; i < len(services.Content); i += 2 {
+		service := services.Content[i]
+		if service.Kind != yaml.MappingNode {
+			return "", fmt.Errorf("service '%s' invalid", services.Content[i-1].Value)
+		}
+		for j := 0; j < len(service.Content); j += 2 {
+			if service.Content[j].Value == "include" {
+				service.Content[j].Value = "bindMounts"
+			}
+		}
+	}
+	return "v2", nil
+}
+
+func getMappingValue(node *yaml.Node, key string) (*yaml.Node, error) {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	if node.Kind != yaml.MappingNode {
+		return nil, errors.New("invalid document")
+	}
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			v := node.Content[i+1]
+			if v.Kind != yaml.MappingNode {
+				if v.Tag == "!!null" {
+					return nil, nil
+				}
+				return nil, fmt.Errorf("'%s' invalid", key)
+			}
+			return v, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,203 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modfile
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+const testYaml = `
+modfileVersion: v2
+id: github.com/test/test-module
+name: Test
+version: v1.0.0
+architectures:
+  - amd64
+  - arm64v8
+volumes:
+  - data
+dependencies:
+  github.com/test/other-module: ">=v1.0.0;<v2.0.0"
+services:
+  web:
+    name: Web
+    image: nginx:1.25
+    archImages:
+      arm64v8: nginx:1.25-arm64
+    runConfig:
+      stopTimeout: 10s
+      command: ["run", "-v"]
+    bindMounts:
+      /etc/test:
+        source: conf
+        readOnly: true
+    tmpfs:
+      /tmp:
+        size: 1024
+        mode: "0755"
+    volumes:
+      /data: data
+    configs:
+      PORT: port
+    httpEndpoints:
+      ui:
+        name: UI
+        port: 80
+        proxyConf:
+          readTimeout: 1m
+configs:
+  port:
+    dataType: int
+    value: 8080
+    options: [8080, 9090]
+    optionsExt: true
+    type: number
+    typeOptions:
+      min: 1
+      max: 65535
+    userInput:
+      name: Port
+  ratio:
+    dataType: float
+    value: 0.5
+    type: number
+    typeOptions:
+      min: 0
+  hosts:
+    dataType: string
+    isList: true
+    value: ["a", "b"]
+    delimiter: ","
+  debug:
+    dataType: bool
+`
+
+const testJson = `{
+  "modfileVersion": "v2",
+  "id": "github.com/test/test-module",
+  "version": "v1.0.0",
+  "services": {
+    "web": {
+      "image": "nginx:1.25",
+      "tmpfs": {"/tmp": {"mode": 493}}
+    }
+  },
+  "configs": {
+    "ids": {"dataType": "int", "isList": true, "value": [1, 2], "options": [1, 2, 3]}
+  }
+}`
+
+func TestDecode(t *testing.T) {
+	m, err := Decode(strings.NewReader(testYaml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.ID != "github.com/test/test-module" {
+		t.Errorf("m.ID != \"%s\"", "github.com/test/test-module")
+	}
+	a := model.Set[string]{"amd64": {}, "arm64v8": {}}
+	if !reflect.DeepEqual(a, m.Architectures) {
+		t.Errorf("%v != %v", a, m.Architectures)
+	}
+	srv, ok := m.Services["web"]
+	if !ok {
+		t.Fatal("service 'web' missing")
+	}
+	if srv.ArchImages["arm64v8"] != "nginx:1.25-arm64" {
+		t.Errorf("%s != nginx:1.25-arm64", srv.ArchImages["arm64v8"])
+	}
+	if srv.RunConfig.StopTimeout != 10*time.Second {
+		t.Errorf("%v != %v", srv.RunConfig.StopTimeout, 10*time.Second)
+	}
+	if srv.Tmpfs["/tmp"].Mode != 0755 {
+		t.Errorf("%o != %o", srv.Tmpfs["/tmp"].Mode, 0755)
+	}
+	if !srv.BindMounts["/etc/test"].ReadOnly {
+		t.Error("srv.BindMounts[\"/etc/test\"].ReadOnly != true")
+	}
+	if srv.HttpEndpoints["ui"].ProxyConf.ReadTimeout != time.Minute {
+		t.Errorf("%v != %v", srv.HttpEndpoints["ui"].ProxyConf.ReadTimeout, time.Minute)
+	}
+	def := int64(8080)
+	cto := make(model.ConfigTypeOptions)
+	cto.SetInt64("min", 1)
+	cto.SetInt64("max", 65535)
+	cs := make(model.Configs)
+	cs.SetInt64("port", &def, []int64{8080, 9090}, true, "number", cto, false)
+	fDef := 0.5
+	fCto := make(model.ConfigTypeOptions)
+	fCto.SetFloat64("min", 0)
+	cs.SetFloat64("ratio", &fDef, nil, false, "number", fCto, false)
+	cs.SetStringSlice("hosts", []string{"a", "b"}, nil, false, "", nil, ",", false)
+	cs.SetBool("debug", nil, nil, false, "", nil, false)
+	if !reflect.DeepEqual(cs, m.Configs) {
+		t.Errorf("%v != %v", cs, m.Configs)
+	}
+	if m.Inputs.Configs["port"].Name != "Port" {
+		t.Error("m.Inputs.Configs[\"port\"].Name != \"Port\"")
+	}
+	// ------------------------------
+	m, err = Decode(strings.NewReader(testJson))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Services["web"].Tmpfs["/tmp"].Mode != 0755 {
+		t.Errorf("%o != %o", m.Services["web"].Tmpfs["/tmp"].Mode, 0755)
+	}
+	cs = make(model.Configs)
+	cs.SetInt64Slice("ids", []int64{1, 2}, []int64{1, 2, 3}, false, "", nil, "", false)
+	if !reflect.DeepEqual(cs, m.Configs) {
+		t.Errorf("%v != %v", cs, m.Configs)
+	}
+	// ------------------------------
+	errInputs := []string{
+		"",
+		"id: test",
+		"modfileVersion: v0\nid: test",
+		"modfileVersion: v2\nconfigs:\n  test:\n    dataType: test",
+		"modfileVersion: v2\nconfigs:\n  test:\n    dataType: int\n    value: test",
+		"modfileVersion: v2\nconfigs:\n  test:\n    dataType: int\n    isList: true\n    value: 1",
+		"modfileVersion: v2\nservices:\n  test:\n    runConfig:\n      stopTimeout: 10",
+		"modfileVersion: v2\nservices:\n  test:\n    tmpfs:\n      /tmp:\n        mode: \"999\"",
+	}
+	for _, s := range errInputs {
+		if _, err = Decode(strings.NewReader(s)); err == nil {
+			t.Errorf("Decode(\"%s\"); err == nil", s)
+		}
+	}
+}
+
+func TestDecodeUpgrade(t *testing.T) {
+	s := "modfileVersion: v1\nid: test\nservices:\n  test:\n    include:\n      /etc/test:\n        source: test"
+	m, err := Decode(strings.NewReader(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := map[string]model.BindMount{"/etc/test": {Source: "test"}}
+	if !reflect.DeepEqual(a, m.Services["test"].BindMounts) {
+		t.Errorf("%v != %v", a, m.Services["test"].BindMounts)
+	}
+	s = "modfileVersion: v1\nid: test\nservices:\n  test: test"
+	if _, err = Decode(strings.NewReader(s)); err == nil {
+		t.Error("err == nil")
+	}
+}
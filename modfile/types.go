@@ -0,0 +1,64 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modfile
+
+import (
+	"fmt"
+	"io/fs"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// duration accepts strings like "10s" or "1m30s".
+type duration time.Duration
+
+func (d *duration) UnmarshalYAML(node *yaml.Node) error {
+	if node.Tag != "!!str" {
+		return fmt.Errorf("line %d: invalid duration '%s'", node.Line, node.Value)
+	}
+	v, err := time.ParseDuration(node.Value)
+	if err != nil {
+		return fmt.Errorf("line %d: %s", node.Line, err)
+	}
+	*d = duration(v)
+	return nil
+}
+
+// fileMode accepts octal strings like "0644" or integers.
+type fileMode fs.FileMode
+
+func (m *fileMode) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Tag {
+	case "!!str":
+		v, err := strconv.ParseUint(node.Value, 8, 32)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid file mode '%s'", node.Line, node.Value)
+		}
+		*m = fileMode(v)
+	case "!!int":
+		var v uint32
+		if err := node.Decode(&v); err != nil {
+			return err
+		}
+		*m = fileMode(v)
+	default:
+		return fmt.Errorf("line %d: invalid file mode '%s'", node.Line, node.Value)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package img_ref
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	LatestTag  = "latest"
+	maxNameLen = 255
+)
+
+var (
+	domainRegex = regexp.MustCompile(`^(?:localhost|(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])(?:\.(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9]))*|\[[a-fA-F0-9:]+\])(?::[0-9]+)?$`)
+	pathRegex   = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+	tagRegex    = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+	digestRegex = regexp.MustCompile(`^[a-z0-9]+(?:[+._-][a-z0-9]+)*:[0-9a-fA-F]{32,}$`)
+)
+
+// Reference of a container image: [domain/]repository[:tag][@digest]
+type Reference struct {
+	Domain     string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+func Parse(s string) (Reference, error) {
+	var ref Reference
+	name := s
+	if i := strings.Index(name, "@"); i > -1 {
+		ref.Digest = name[i+1:]
+		name = name[:i]
+		if !digestRegex.MatchString(ref.Digest) {
+			return Reference{}, fmt.Errorf("invalid digest format '%s'", ref.Digest)
+		}
+	}
+	if i := strings.LastIndex(name, ":"); i > -1 && !strings.Contains(name[i+1:], "/") {
+		ref.Tag = name[i+1:]
+		name = name[:i]
+		if !tagRegex.MatchString(ref.Tag) {
+			return Reference{}, fmt.Errorf("invalid tag format '%s'", ref.Tag)
+		}
+	}
+	if name == "" {
+		return Reference{}, fmt.Errorf("invalid reference format '%s': empty name", s)
+	}
+	if len(name) > maxNameLen {
+		return Reference{}, fmt.Errorf("invalid reference format '%s': name exceeds %d characters", s, maxNameLen)
+	}
+	ref.Repository = name
+	if i := strings.Index(name, "/"); i > -1 && isDomain(name[:i]) {
+		ref.Domain = name[:i]
+		ref.Repository = name[i+1:]
+		if !domainRegex.MatchString(ref.Domain) {
+			return Reference{}, fmt.Errorf("invalid domain format '%s'", ref.Domain)
+		}
+	}
+	if !pathRegex.MatchString(ref.Repository) {
+		if strings.ToLower(ref.Repository) != ref.Repository {
+			return Reference{}, fmt.Errorf("invalid repository format '%s': must be lowercase", ref.Repository)
+		}
+		return Reference{}, fmt.Errorf("invalid repository format '%s'", ref.Repository)
+	}
+	return ref, nil
+}
+
+func (r Reference) Name() string {
+	if r.Domain == "" {
+		return r.Repository
+	}
+	return r.Domain + "/" + r.Repository
+}
+
+func (r Reference) String() string {
+	s := r.Name()
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+// Pinned checks if the reference has a digest or a tag other than latest.
+func (r Reference) Pinned() bool {
+	return r.Digest != "" || (r.Tag != "" && r.Tag != LatestTag)
+}
+
+// isDomain checks if the first name component is a registry domain, as done by docker.
+func isDomain(s string) bool {
+	return strings.ContainsAny(s, ".:") || s == "localhost" || strings.ToLower(s) != s
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package img_ref
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testDigest = "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+func TestParse(t *testing.T) {
+	ok := map[string]Reference{
+		"nginx":                              {Repository: "nginx"},
+		"nginx:1.25":                         {Repository: "nginx", Tag: "1.25"},
+		"library/nginx:latest":               {Repository: "library/nginx", Tag: "latest"},
+		"ghcr.io/senergy-platform/test:v1":   {Domain: "ghcr.io", Repository: "senergy-platform/test", Tag: "v1"},
+		"localhost:5000/test":                {Domain: "localhost:5000", Repository: "test"},
+		"localhost/test_a__b-c.d":            {Domain: "localhost", Repository: "test_a__b-c.d"},
+		"Registry/test":                      {Domain: "Registry", Repository: "test"},
+		"[::1]:5000/test:v1":                 {Domain: "[::1]:5000", Repository: "test", Tag: "v1"},
+		"nginx@" + testDigest:                {Repository: "nginx", Digest: testDigest},
+		"docker.io/nginx:1.25@" + testDigest: {Domain: "docker.io", Repository: "nginx", Tag: "1.25", Digest: testDigest},
+	}
+	for s, a := range ok {
+		b, err := Parse(s)
+		if err != nil {
+			t.Errorf("Parse(\"%s\"); err != nil", s)
+			continue
+		}
+		if !reflect.DeepEqual(a, b) {
+			t.Errorf("%v != %v", a, b)
+		}
+		if b.String() != s {
+			t.Errorf("%s != %s", b.String(), s)
+		}
+	}
+	notOk := []string{
+		"",
+		":1.25",
+		"nginx:latest:1",
+		"Nginx",
+		"test/Nginx",
+		"nginx:",
+		"nginx:-1",
+		"nginx@sha256:abc",
+		"nginx@" + testDigest + "@" + testDigest,
+		"-nginx",
+		"nginx-",
+		"nginx//test",
+		"test_-.io/test",
+	}
+	for _, s := range notOk {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("Parse(\"%s\"); err == nil", s)
+		}
+	}
+}
+
+func TestReference_Pinned(t *testing.T) {
+	a := map[string]bool{
+		"nginx":                      false,
+		"nginx:latest":               false,
+		"nginx:1.25":                 true,
+		"nginx@" + testDigest:        true,
+		"nginx:latest@" + testDigest: true,
+	}
+	for s, p := range a {
+		ref, err := Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ref.Pinned() != p {
+			t.Errorf("Parse(\"%s\").Pinned() != %v", s, p)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package img_ref
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	DefaultDomain      = "docker.io"
+	legacyDomain       = "index.docker.io"
+	officialRepoPrefix = "library/"
+	wildcard           = "*"
+)
+
+var patternRegex = regexp.MustCompile(`^[a-zA-Z0-9\/_\.-]+$`)
+
+// Matcher checks image references against patterns that are either exact image names or name prefixes ending with '*'.
+// Patterns are not case-sensitive.
+type Matcher struct {
+	exact    map[string]struct{}
+	prefixes []string
+}
+
+func NewMatcher(patterns map[string]struct{}) (*Matcher, error) {
+	m := &Matcher{exact: make(map[string]struct{})}
+	for p := range patterns {
+		if err := ValidatePattern(p); err != nil {
+			return nil, err
+		}
+		p = strings.ToLower(p)
+		if prefix, ok := strings.CutSuffix(p, wildcard); ok {
+			m.addPrefix(prefix)
+		} else {
+			m.exact[normalizeName(p, false)] = struct{}{}
+		}
+	}
+	return m, nil
+}
+
+// addPrefix adds a prefix as is and normalized, with and without the library repository prefix if it ends in a docker.io repository name.
+func (m *Matcher) addPrefix(p string) {
+	n := normalizeName(p, true)
+	prefixes := []string{p, n}
+	if domain, rest := splitName(n); domain == DefaultDomain && !strings.Contains(rest, "/") {
+		prefixes = append(prefixes, domain+"/"+officialRepoPrefix+rest)
+	}
+	for _, prefix := range prefixes {
+		if !slices.Contains(m.prefixes, prefix) {
+			m.prefixes = append(m.prefixes, prefix)
+		}
+	}
+}
+
+func (m *Matcher) Match(image string) (bool, error) {
+	ref, err := Parse(image)
+	if err != nil {
+		return false, err
+	}
+	return m.MatchRef(ref), nil
+}
+
+func (m *Matcher) MatchRef(ref Reference) bool {
+	name := ref.Normalize().Name()
+	if _, ok := m.exact[name]; ok {
+		return true
+	}
+	rawName := strings.ToLower(ref.Name())
+	for _, prefix := range m.prefixes {
+		if strings.HasPrefix(name, prefix) || strings.HasPrefix(rawName, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatePattern checks if p is a valid image name or a non-empty name prefix ending with '*'.
+func ValidatePattern(p string) error {
+	if prefix, ok := strings.CutSuffix(p, wildcard); ok {
+		if !patternRegex.MatchString(prefix) {
+			return fmt.Errorf("invalid pattern '%s'", p)
+		}
+		return nil
+	}
+	ref, err := Parse(strings.ToLower(p))
+	if err != nil {
+		return fmt.Errorf("invalid pattern '%s': %s", p, err)
+	}
+	if ref.Tag != "" || ref.Digest != "" {
+		return fmt.Errorf("invalid pattern '%s': tag or digest not allowed", p)
+	}
+	return nil
+}
+
+// Normalize adds the implicit docker.io domain and library repository prefix, e.g. nginx -> docker.io/library/nginx.
+func (r Reference) Normalize() Reference {
+	domain, repo := splitName(normalizeName(r.Name(), false))
+	r.Domain = domain
+	r.Repository = repo
+	return r
+}
+
+// normalizeName normalizes an image name or, if prefix is set, the domain of a name prefix.
+// Prefixes without '/' that contain a registry host, e.g. ghcr.io, are not normalized.
+func normalizeName(s string, prefix bool) string {
+	if prefix && !strings.Contains(s, "/") && isRegistryHost(s) {
+		return strings.ToLower(s)
+	}
+	domain, rest := splitName(s)
+	domain = strings.ToLower(domain)
+	if domain == "" || domain == legacyDomain {
+		domain = DefaultDomain
+	}
+	if domain == DefaultDomain && !strings.Contains(rest, "/") && !prefix {
+		rest = officialRepoPrefix + rest
+	}
+	return domain + "/" + rest
+}
+
+func splitName(s string) (string, string) {
+	if i := strings.Index(s, "/"); i > -1 && isDomain(s[:i]) {
+		return s[:i], s[i+1:]
+	}
+	return "", s
+}
+
+func isRegistryHost(s string) bool {
+	return strings.ContainsAny(s, ".:") || strings.HasPrefix(s, "localhost")
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package img_ref
+
+import "testing"
+
+func TestMatcher(t *testing.T) {
+	m, err := NewMatcher(map[string]struct{}{
+		"nginx":                         {},
+		"ghcr.io/senergy-platform/*":    {},
+		"senergy/mgw-*":                 {},
+		"registry.local:5000/test":      {},
+		"index.docker.io/library/redis": {},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok := []string{
+		"nginx",
+		"nginx:1.25",
+		"library/nginx:latest",
+		"docker.io/library/nginx@" + testDigest,
+		"index.docker.io/nginx",
+		"ghcr.io/senergy-platform/test:v1",
+		"ghcr.io/senergy-platform/test/sub",
+		"senergy/mgw-test:v1",
+		"docker.io/senergy/mgw-test",
+		"registry.local:5000/test:v1",
+		"redis:7",
+	}
+	notOk := []string{
+		"nginx-test",
+		"test/nginx",
+		"ghcr.io/nginx",
+		"ghcr.io/senergy-platform-test/test",
+		"senergy/test",
+		"registry.local/test",
+	}
+	for _, s := range ok {
+		if b, err := m.Match(s); err != nil || !b {
+			t.Errorf("m.Match(\"%s\"); err != nil || !b", s)
+		}
+	}
+	for _, s := range notOk {
+		if b, err := m.Match(s); err != nil || b {
+			t.Errorf("m.Match(\"%s\"); err != nil || b", s)
+		}
+	}
+	if _, err = m.Match("nginx:latest:1"); err == nil {
+		t.Error("err == nil")
+	}
+	m, err = NewMatcher(map[string]struct{}{"ghcr.io*": {}, "Senergy/Test": {}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"ghcr.io/test", "ghcr.io/senergy-platform/test:v1", "senergy/test"} {
+		if b, err := m.Match(s); err != nil || !b {
+			t.Errorf("m.Match(\"%s\"); err != nil || !b", s)
+		}
+	}
+	if b, err := m.Match("ghcr/test"); err != nil || b {
+		t.Error("err != nil || b")
+	}
+	m, err = NewMatcher(map[string]struct{}{"docker.io/senergy*": {}, "ngin*": {}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"docker.io/senergyplatform/x", "senergyplatform/x", "senergy/x", "senergy-foo", "nginx", "docker.io/library/nginx", "nginx-test:v1"} {
+		if b, err := m.Match(s); err != nil || !b {
+			t.Errorf("m.Match(\"%s\"); err != nil || !b", s)
+		}
+	}
+	for _, s := range []string{"ghcr.io/senergyplatform/x", "test/senergy", "test/nginx", "redis"} {
+		if b, err := m.Match(s); err != nil || b {
+			t.Errorf("m.Match(\"%s\"); err != nil || b", s)
+		}
+	}
+	if _, err = NewMatcher(map[string]struct{}{"*": {}}); err == nil {
+		t.Error("err == nil")
+	}
+	if _, err = NewMatcher(map[string]struct{}{"nginx:1.25": {}}); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestValidatePattern(t *testing.T) {
+	for _, p := range []string{"nginx", "Nginx", "ghcr.io/senergy-platform/*", "ghcr.io*", "senergy/mgw-*", "Senergy/*", "registry.local:5000/test"} {
+		if err := ValidatePattern(p); err != nil {
+			t.Errorf("ValidatePattern(\"%s\"); err != nil", p)
+		}
+	}
+	for _, p := range []string{"", "nginx:1.25", "nginx@" + testDigest, "ghcr.io/*/test", "test*/*", "*", "test$*"} {
+		if err := ValidatePattern(p); err == nil {
+			t.Errorf("ValidatePattern(\"%s\"); err == nil", p)
+		}
+	}
+}
+
+func TestReference_Normalize(t *testing.T) {
+	a := map[string]string{
+		"nginx":                  "docker.io/library/nginx",
+		"senergy/test:v1":        "docker.io/senergy/test:v1",
+		"index.docker.io/nginx":  "docker.io/library/nginx",
+		"ghcr.io/test":           "ghcr.io/test",
+		"localhost:5000/test:v1": "localhost:5000/test:v1",
+	}
+	for s, n := range a {
+		ref, err := Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b := ref.Normalize().String(); b != n {
+			t.Errorf("%s != %s", b, n)
+		}
+	}
+}
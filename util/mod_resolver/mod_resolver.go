@@ -0,0 +1,273 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mod_resolver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"github.com/SENERGY-Platform/mgw-module-lib/util/sem_ver"
+	"github.com/SENERGY-Platform/mgw-module-lib/util/tsort"
+)
+
+type Catalog interface {
+	GetVersions(id string) ([]string, error)
+	GetDependencies(id, version string) (map[string]string, error) // {moduleID:moduleVersionRange}
+}
+
+type Result struct {
+	Selected map[string]string // {moduleID:moduleVersion}
+	Order    []string          // dependencies before dependents
+}
+
+type Constraint struct {
+	From   string
+	Module string
+	Range  string
+}
+
+func (c Constraint) String() string {
+	return fmt.Sprintf("%s requires %s %s", c.From, c.Module, c.Range)
+}
+
+type ConflictError struct {
+	Module      string
+	Constraints []Constraint
+}
+
+func (e *ConflictError) Error() string {
+	var sl []string
+	for _, c := range e.Constraints {
+		sl = append(sl, c.String())
+	}
+	return fmt.Sprintf("cannot resolve '%s': %s", e.Module, strings.Join(sl, ", "))
+}
+
+// Resolve selects a version for every direct and transitive dependency of the module. Newer versions are preferred.
+func Resolve(c Catalog, m model.Module) (Result, error) {
+	r := resolver{
+		catalog:     c,
+		versions:    make(map[string][]string),
+		deps:        make(map[string]map[string]string),
+		selected:    map[string]string{m.ID: m.Version},
+		constraints: make(map[string][]Constraint),
+	}
+	if err := r.check(m.ID, m.Dependencies); err != nil {
+		return Result{}, err
+	}
+	r.push(m.ID, m.Dependencies)
+	r.deps[key(m.ID, m.Version)] = m.Dependencies
+	ok, err := r.resolve()
+	if err != nil {
+		return Result{}, err
+	}
+	if !ok {
+		return Result{}, r.conflict
+	}
+	nodes := make(tsort.Nodes)
+	for id, ver := range r.selected {
+		inRefs := make(map[string]struct{})
+		for dID := range r.deps[key(id, ver)] {
+			inRefs[dID] = struct{}{}
+		}
+		nodes.Add(id, inRefs, nil)
+	}
+	order, err := tsort.GetTopOrder(nodes)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{
+		Selected: r.selected,
+		Order:    order,
+	}, nil
+}
+
+type resolver struct {
+	catalog     Catalog
+	versions    map[string][]string          // {moduleID:[moduleVersion]}
+	deps        map[string]map[string]string // {moduleID@moduleVersion:{moduleID:moduleVersionRange}}
+	selected    map[string]string            // {moduleID:moduleVersion}
+	constraints map[string][]Constraint      // {moduleID:[Constraint]}
+	conflict    *ConflictError
+}
+
+func (r *resolver) resolve() (bool, error) {
+	id := r.next()
+	if id == "" {
+		return true, nil
+	}
+	versions, err := r.getVersions(id)
+	if err != nil {
+		return false, err
+	}
+	var candidates []string
+	for _, ver := range versions {
+		ok, err := r.satisfies(id, ver)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			candidates = append(candidates, ver)
+		}
+	}
+	if len(candidates) == 0 {
+		r.setConflict(id, r.constraints[id])
+		return false, nil
+	}
+	for _, ver := range candidates {
+		deps, err := r.getDependencies(id, ver)
+		if err != nil {
+			return false, err
+		}
+		if err = r.check(id, deps); err != nil {
+			if _, ok := err.(*ConflictError); ok {
+				continue
+			}
+			return false, err
+		}
+		r.selected[id] = ver
+		r.push(id, deps)
+		ok, err := r.resolve()
+		if err != nil || ok {
+			return ok, err
+		}
+		r.pop(deps)
+		delete(r.selected, id)
+	}
+	return false, nil
+}
+
+// next returns the lexically smallest required module without selected version.
+func (r *resolver) next() string {
+	var ids []string
+	for id, cs := range r.constraints {
+		if _, ok := r.selected[id]; !ok && len(cs) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return ""
+	}
+	sort.Strings(ids)
+	return ids[0]
+}
+
+func (r *resolver) satisfies(id, ver string) (bool, error) {
+	for _, c := range r.constraints[id] {
+		ok, err := sem_ver.InSemVerRange(c.Range, ver)
+		if err != nil {
+			return false, fmt.Errorf("%s: %s", c, err)
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// check returns a ConflictError if the dependencies of a module do not allow the already selected versions.
+func (r *resolver) check(id string, deps map[string]string) error {
+	for _, dID := range sortedKeys(deps) {
+		c := Constraint{From: id, Module: dID, Range: deps[dID]}
+		if err := sem_ver.ValidateSemVerRange(c.Range); err != nil {
+			return fmt.Errorf("%s: %s", c, err)
+		}
+		ver, ok := r.selected[dID]
+		if !ok {
+			continue
+		}
+		ok, err := sem_ver.InSemVerRange(c.Range, ver)
+		if err != nil {
+			return fmt.Errorf("%s: %s", c, err)
+		}
+		if !ok {
+			cs := append(append([]Constraint(nil), r.constraints[dID]...), c)
+			r.setConflict(dID, cs)
+			return &ConflictError{Module: dID, Constraints: cs}
+		}
+	}
+	return nil
+}
+
+func (r *resolver) push(id string, deps map[string]string) {
+	for _, dID := range sortedKeys(deps) {
+		r.constraints[dID] = append(r.constraints[dID], Constraint{From: id, Module: dID, Range: deps[dID]})
+	}
+}
+
+func (r *resolver) pop(deps map[string]string) {
+	for dID := range deps {
+		r.constraints[dID] = r.constraints[dID][:len(r.constraints[dID])-1]
+	}
+}
+
+// setConflict keeps the first conflict, which belongs to the search path with the newest versions.
+func (r *resolver) setConflict(id string, cs []Constraint) {
+	if r.conflict == nil {
+		r.conflict = &ConflictError{Module: id, Constraints: append([]Constraint(nil), cs...)}
+	}
+}
+
+func (r *resolver) getVersions(id string) ([]string, error) {
+	if versions, ok := r.versions[id]; ok {
+		return versions, nil
+	}
+	all, err := r.catalog.GetVersions(id)
+	if err != nil {
+		return nil, err
+	}
+	var versions []string
+	for _, ver := range all {
+		if sem_ver.IsValidSemVer(ver) {
+			versions = append(versions, ver)
+		}
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		res, _ := sem_ver.CompareSemVer(versions[i], versions[j])
+		return res > 0
+	})
+	r.versions[id] = versions
+	return versions, nil
+}
+
+func (r *resolver) getDependencies(id, ver string) (map[string]string, error) {
+	k := key(id, ver)
+	if deps, ok := r.deps[k]; ok {
+		return deps, nil
+	}
+	deps, err := r.catalog.GetDependencies(id, ver)
+	if err != nil {
+		return nil, err
+	}
+	r.deps[k] = deps
+	return deps, nil
+}
+
+func key(id, ver string) string {
+	return id + "@" + ver
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
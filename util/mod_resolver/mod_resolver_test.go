@@ -0,0 +1,132 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mod_resolver
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+type testCatalog map[string]map[string]map[string]string // {moduleID:{moduleVersion:{moduleID:moduleVersionRange}}}
+
+func (c testCatalog) GetVersions(id string) ([]string, error) {
+	versions, ok := c[id]
+	if !ok {
+		return nil, fmt.Errorf("module '%s' not found", id)
+	}
+	var sl []string
+	for ver := range versions {
+		sl = append(sl, ver)
+	}
+	return sl, nil
+}
+
+func (c testCatalog) GetDependencies(id, version string) (map[string]string, error) {
+	deps, ok := c[id][version]
+	if !ok {
+		return nil, fmt.Errorf("module '%s@%s' not found", id, version)
+	}
+	return deps, nil
+}
+
+func TestResolve(t *testing.T) {
+	c := testCatalog{
+		"a": {
+			"v1.0.0": {"b": ">=v1.0.0"},
+			"v1.1.0": {"b": ">=v2.0.0", "c": ">=v1.0.0"},
+		},
+		"b": {
+			"v1.0.0": nil,
+			"v2.0.0": nil,
+			"v2.1.0": {"d": "<v2.0.0"},
+		},
+		"c": {
+			"v1.0.0": {"b": "<v2.1.0"},
+		},
+		"d": {
+			"v1.0.0": nil,
+		},
+	}
+	m := model.Module{
+		ID:           "x",
+		Version:      "v1.0.0",
+		Dependencies: map[string]string{"a": ">=v1.0.0"},
+	}
+	r, err := Resolve(c, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := map[string]string{"x": "v1.0.0", "a": "v1.1.0", "b": "v2.0.0", "c": "v1.0.0"}
+	if !reflect.DeepEqual(a, r.Selected) {
+		t.Errorf("%v != %v", a, r.Selected)
+	}
+	a2 := []string{"b", "c", "a", "x"}
+	if !reflect.DeepEqual(a2, r.Order) {
+		t.Errorf("%v != %v", a2, r.Order)
+	}
+	// ------------------------------
+	m.Dependencies = map[string]string{"a": "<v1.1.0"}
+	r, err = Resolve(c, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a = map[string]string{"x": "v1.0.0", "a": "v1.0.0", "b": "v2.1.0", "d": "v1.0.0"}
+	if !reflect.DeepEqual(a, r.Selected) {
+		t.Errorf("%v != %v", a, r.Selected)
+	}
+	// ------------------------------
+	m.Dependencies = map[string]string{"a": ">v2.0.0"}
+	if _, err = Resolve(c, m); err == nil {
+		t.Error("err == nil")
+	}
+	m.Dependencies = map[string]string{"e": ">v1.0.0"}
+	if _, err = Resolve(c, m); err == nil {
+		t.Error("err == nil")
+	}
+	m.Dependencies = map[string]string{"a": "test"}
+	if _, err = Resolve(c, m); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestResolveConflict(t *testing.T) {
+	c := testCatalog{
+		"a": {"v1.0.0": {"b": ">=v2.0.0"}},
+		"b": {"v1.0.0": nil, "v2.0.0": nil},
+		"c": {"v1.0.0": {"b": "<v2.0.0"}},
+	}
+	m := model.Module{
+		ID:           "x",
+		Version:      "v1.0.0",
+		Dependencies: map[string]string{"a": ">=v1.0.0", "c": ">=v1.0.0"},
+	}
+	_, err := Resolve(c, m)
+	var cErr *ConflictError
+	if !errors.As(err, &cErr) {
+		t.Fatal("!errors.As(err, &cErr)")
+	}
+	if cErr.Module != "b" {
+		t.Errorf("%s != b", cErr.Module)
+	}
+	if s := "cannot resolve 'b': a requires b >=v2.0.0, c requires b <v2.0.0"; err.Error() != s {
+		t.Errorf("\"%s\" != \"%s\"", err, s)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sem_ver
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+type Channel = string
+
+const (
+	StableChannel Channel = "stable"
+	BetaChannel   Channel = "beta"
+	AlphaChannel  Channel = "alpha"
+)
+
+var channelRank = map[Channel]int{
+	StableChannel: 0,
+	BetaChannel:   1,
+	AlphaChannel:  2,
+}
+
+// GetChannel returns the channel of a version: versions without prerelease are stable, "beta" and "rc" prereleases are beta and all other prereleases are alpha.
+func GetChannel(v string) (Channel, error) {
+	if !semver.IsValid(v) {
+		return "", fmt.Errorf("format '%s' invalid", v)
+	}
+	pre := strings.TrimPrefix(semver.Prerelease(v), "-")
+	switch {
+	case pre == "":
+		return StableChannel, nil
+	case strings.HasPrefix(pre, "beta"), strings.HasPrefix(pre, "rc"):
+		return BetaChannel, nil
+	}
+	return AlphaChannel, nil
+}
+
+// InChannel checks if a version is at least as stable as required by the channel, e.g. the beta channel includes stable versions.
+func InChannel(v string, c Channel) (bool, error) {
+	rank, ok := channelRank[c]
+	if !ok {
+		return false, fmt.Errorf("channel '%s' invalid", c)
+	}
+	vc, err := GetChannel(v)
+	if err != nil {
+		return false, err
+	}
+	return channelRank[vc] <= rank, nil
+}
+
+func FilterChannel(versions []string, c Channel) ([]string, error) {
+	var sl []string
+	for _, v := range versions {
+		ok, err := InChannel(v, c)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			sl = append(sl, v)
+		}
+	}
+	return sl, nil
+}
+
+// MaxSatisfyingChannel returns the highest version of the channel that is in range r. Prereleases allowed by the channel are not excluded by the range.
+func MaxSatisfyingChannel(versions []string, r string, c Channel) (string, bool, error) {
+	versions, err := FilterChannel(versions, c)
+	if err != nil {
+		return "", false, err
+	}
+	return satisfying(versions, r, 1, c != StableChannel)
+}
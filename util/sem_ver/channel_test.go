@@ -0,0 +1,100 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sem_ver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInSemVerRange_Prerelease(t *testing.T) {
+	ok := [][2]string{
+		{">=v1.0.0-rc.1", "v1.0.0-rc.2"},
+		{">=v1.0.0-rc.1", "v1.0.0"},
+		{">=v1.0.0-rc.1", "v1.1.0"},
+		{"=v1.0.0", "v1.0.0+build.1"},
+		{"^v1.2.0-beta", "v1.2.0-beta.2"},
+		{">=v1.0.0;<v2.0.0-rc.2", "v2.0.0-rc.1"},
+	}
+	notOk := [][2]string{
+		{">=v1.0.0", "v2.0.0-rc.1"},
+		{">=v1.0.0-rc.1", "v1.1.0-rc.1"},
+		{"^v1.2.0-beta", "v1.3.0-beta"},
+		{"v1", "v1.5.0-alpha"},
+		{"v1 || >=v1.0.0-rc.1", "v1.0.1-rc.1"},
+	}
+	for _, v := range ok {
+		if k, err := InSemVerRange(v[0], v[1]); err != nil || !k {
+			t.Errorf("InSemVerRange(\"%s\", \"%s\"); err != nil || k != true", v[0], v[1])
+		}
+	}
+	for _, v := range notOk {
+		if k, err := InSemVerRange(v[0], v[1]); err != nil || k {
+			t.Errorf("InSemVerRange(\"%s\", \"%s\"); err != nil || k != false", v[0], v[1])
+		}
+	}
+}
+
+func TestGetChannel(t *testing.T) {
+	a := map[string]Channel{
+		"v1.0.0":         StableChannel,
+		"v1.0.0+build":   StableChannel,
+		"v1.0.0-beta.1":  BetaChannel,
+		"v1.0.0-rc.1":    BetaChannel,
+		"v1.0.0-alpha.1": AlphaChannel,
+		"v1.0.0-dev":     AlphaChannel,
+	}
+	for v, c := range a {
+		if b, err := GetChannel(v); err != nil {
+			t.Errorf("GetChannel(\"%s\"); err != nil", v)
+		} else if b != c {
+			t.Errorf("%s != %s", b, c)
+		}
+	}
+	if _, err := GetChannel("test"); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestFilterChannel(t *testing.T) {
+	versions := []string{"v1.0.0", "v1.1.0-alpha", "v1.1.0-beta", "v1.1.0"}
+	a := []string{"v1.0.0", "v1.1.0-beta", "v1.1.0"}
+	if b, err := FilterChannel(versions, BetaChannel); err != nil {
+		t.Error("err != nil")
+	} else if !reflect.DeepEqual(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+	if _, err := FilterChannel(versions, "test"); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestMaxSatisfyingChannel(t *testing.T) {
+	versions := []string{"v1.0.0", "v1.1.0", "v1.2.0-beta", "v1.3.0-alpha", "v2.0.0-rc.1"}
+	a := map[Channel]string{
+		StableChannel: "v1.1.0",
+		BetaChannel:   "v1.2.0-beta",
+		AlphaChannel:  "v1.3.0-alpha",
+	}
+	for c, v := range a {
+		if b, ok, err := MaxSatisfyingChannel(versions, "^v1.0", c); err != nil || !ok {
+			t.Errorf("MaxSatisfyingChannel(%v, \"^v1.0\", \"%s\"); err != nil || !ok", versions, c)
+		} else if b != v {
+			t.Errorf("%s != %s", b, v)
+		}
+	}
+}
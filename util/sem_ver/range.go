@@ -0,0 +1,284 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sem_ver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+const (
+	orSeparator  = "||"
+	andSeparator = ";"
+	caret        = "^"
+	tilde        = "~"
+)
+
+type comparator struct {
+	opr string
+	ver string
+}
+
+// semVerRange matches a version if all comparators of at least one alternative match.
+type semVerRange [][]comparator
+
+// check excludes prereleases unless includePre is set or a comparator of the alternative names a prerelease of the same core version.
+func (r semVerRange) check(v string, includePre bool) bool {
+	pre := semver.Prerelease(v) != ""
+	for _, alt := range r {
+		ok := true
+		allowPre := includePre || !pre
+		for _, c := range alt {
+			if !semVerRangeCheck(c.opr, c.ver, v) {
+				ok = false
+				break
+			}
+			// <v2.0.0 also excludes the prereleases of v2.0.0
+			if pre && c.opr == Less && semver.Prerelease(c.ver) == "" && core(c.ver) == core(v) {
+				ok = false
+				break
+			}
+			if !allowPre && semver.Prerelease(c.ver) != "" && core(c.ver) == core(v) {
+				allowPre = true
+			}
+		}
+		if ok && allowPre {
+			return true
+		}
+	}
+	return false
+}
+
+func parseRange(s string) (semVerRange, error) {
+	var r semVerRange
+	for _, p := range strings.Split(s, orSeparator) {
+		alt, err := parseAlternative(strings.TrimSpace(p))
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, alt)
+	}
+	return r, nil
+}
+
+func parseAlternative(s string) ([]comparator, error) {
+	if strings.Contains(s, andSeparator) {
+		opr, ver, err := semVerRangeParse(s)
+		if err != nil {
+			return nil, err
+		}
+		var alt []comparator
+		for i := range opr {
+			alt = append(alt, comparator{opr: opr[i], ver: ver[i]})
+		}
+		return alt, nil
+	}
+	switch {
+	case s == "*" || s == "x" || s == "X":
+		return []comparator{}, nil
+	case strings.HasPrefix(s, caret):
+		return parseCaret(s[len(caret):])
+	case strings.HasPrefix(s, tilde):
+		return parseTilde(s[len(tilde):])
+	case strings.HasPrefix(s, "v"):
+		return parseWildcard(s)
+	}
+	o, v, err := semVerRangeParsePart(s)
+	if err != nil {
+		return nil, err
+	}
+	return []comparator{{opr: o, ver: v}}, nil
+}
+
+// parseCaret allows changes that do not modify the left-most non-zero component: ^v1.2.3 := >=v1.2.3;<v2.0.0, ^v0.2.3 := >=v0.2.3;<v0.3.0
+func parseCaret(s string) ([]comparator, error) {
+	n, c, err := parseComponents(s)
+	if err != nil {
+		return nil, err
+	}
+	var upper string
+	switch {
+	case c[0] > 0 || n == 1:
+		upper = fmtVersion(c[0]+1, 0, 0)
+	case c[1] > 0 || n == 2:
+		upper = fmtVersion(0, c[1]+1, 0)
+	default:
+		upper = fmtVersion(0, 0, c[2]+1)
+	}
+	return []comparator{{opr: GreaterEqual, ver: s}, {opr: Less, ver: upper}}, nil
+}
+
+// parseTilde allows patch level changes if a minor version is given: ~v1.2.3 := >=v1.2.3;<v1.3.0, ~v1 := >=v1.0.0;<v2.0.0
+func parseTilde(s string) ([]comparator, error) {
+	n, c, err := parseComponents(s)
+	if err != nil {
+		return nil, err
+	}
+	upper := fmtVersion(c[0], c[1]+1, 0)
+	if n == 1 {
+		upper = fmtVersion(c[0]+1, 0, 0)
+	}
+	return []comparator{{opr: GreaterEqual, ver: s}, {opr: Less, ver: upper}}, nil
+}
+
+// parseWildcard handles bare versions where missing or x components match any value: v1.x := >=v1.0.0;<v2.0.0
+func parseWildcard(s string) ([]comparator, error) {
+	v := s
+	for _, w := range []string{".x", ".X", ".*"} {
+		for strings.HasSuffix(v, w) {
+			v = strings.TrimSuffix(v, w)
+		}
+	}
+	if strings.ContainsAny(v, "xX*") {
+		return nil, fmt.Errorf("format '%s' invalid", s)
+	}
+	n, c, err := parseComponents(v)
+	if err != nil {
+		return nil, err
+	}
+	switch n {
+	case 1:
+		return []comparator{{opr: GreaterEqual, ver: v}, {opr: Less, ver: fmtVersion(c[0]+1, 0, 0)}}, nil
+	case 2:
+		return []comparator{{opr: GreaterEqual, ver: v}, {opr: Less, ver: fmtVersion(c[0], c[1]+1, 0)}}, nil
+	}
+	return nil, fmt.Errorf("operator missing '%s'", s)
+}
+
+// parseComponents returns the number of given components and the major, minor and patch values of a version.
+func parseComponents(s string) (int, [3]int, error) {
+	var c [3]int
+	if !semver.IsValid(s) {
+		return 0, c, fmt.Errorf("format '%s' invalid", s)
+	}
+	core := strings.TrimPrefix(s, "v")
+	if i := strings.IndexAny(core, "-+"); i > -1 {
+		core = core[:i]
+	}
+	parts := strings.Split(core, ".")
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return 0, c, fmt.Errorf("format '%s' invalid", s)
+		}
+		c[i] = n
+	}
+	return len(parts), c, nil
+}
+
+// core returns the canonical version without prerelease and build metadata.
+func core(v string) string {
+	c := semver.Canonical(v)
+	return strings.TrimSuffix(c, semver.Prerelease(c))
+}
+
+func fmtVersion(major, minor, patch int) string {
+	return fmt.Sprintf("v%d.%d.%d", major, minor, patch)
+}
+
+type bound struct {
+	ver  string
+	incl bool
+}
+
+// interval is the version span matched by the comparators of an alternative, nil bounds are unlimited.
+type interval struct {
+	lower *bound
+	upper *bound
+}
+
+func newInterval(alt []comparator) interval {
+	var i interval
+	for _, c := range alt {
+		switch c.opr {
+		case Greater, GreaterEqual:
+			i.setLower(bound{ver: c.ver, incl: c.opr == GreaterEqual})
+		case Less, LessEqual:
+			i.setUpper(bound{ver: c.ver, incl: c.opr == LessEqual})
+		case Equal:
+			i.setLower(bound{ver: c.ver, incl: true})
+			i.setUpper(bound{ver: c.ver, incl: true})
+		}
+	}
+	return i
+}
+
+func (i *interval) setLower(b bound) {
+	if i.lower != nil {
+		res := semver.Compare(b.ver, i.lower.ver)
+		if res < 0 || (res == 0 && b.incl) {
+			return
+		}
+	}
+	i.lower = &b
+}
+
+func (i *interval) setUpper(b bound) {
+	if i.upper != nil {
+		res := semver.Compare(b.ver, i.upper.ver)
+		if res > 0 || (res == 0 && b.incl) {
+			return
+		}
+	}
+	i.upper = &b
+}
+
+func (i interval) intersect(j interval) interval {
+	if j.lower != nil {
+		i.setLower(*j.lower)
+	}
+	if j.upper != nil {
+		i.setUpper(*j.upper)
+	}
+	return i
+}
+
+func (i interval) empty() bool {
+	if i.lower == nil || i.upper == nil {
+		return false
+	}
+	res := semver.Compare(i.lower.ver, i.upper.ver)
+	return res > 0 || (res == 0 && !(i.lower.incl && i.upper.incl))
+}
+
+func (i interval) String() string {
+	var parts []string
+	if i.lower != nil {
+		if i.upper != nil && i.lower.incl && i.upper.incl && semver.Compare(i.lower.ver, i.upper.ver) == 0 {
+			return Equal + i.lower.ver
+		}
+		if i.lower.incl {
+			parts = append(parts, GreaterEqual+i.lower.ver)
+		} else {
+			parts = append(parts, Greater+i.lower.ver)
+		}
+	}
+	if i.upper != nil {
+		if i.upper.incl {
+			parts = append(parts, LessEqual+i.upper.ver)
+		} else {
+			parts = append(parts, Less+i.upper.ver)
+		}
+	}
+	if len(parts) == 0 {
+		return "*"
+	}
+	return strings.Join(parts, andSeparator)
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sem_ver
+
+import "testing"
+
+func TestInSemVerRange_Ext(t *testing.T) {
+	ok := [][2]string{
+		{"^v1.2", "v1.2.0"},
+		{"^v1.2", "v1.9.1"},
+		{"^v1.2.3", "v1.2.3"},
+		{"^v0.2.3", "v0.2.9"},
+		{"^v0.0.3", "v0.0.3"},
+		{"^v0", "v0.9.0"},
+		{"~v1.4.0", "v1.4.7"},
+		{"~v1.4", "v1.4.1"},
+		{"~v1", "v1.9.0"},
+		{"v1.x", "v1.0.0"},
+		{"v1.x", "v1.9.9"},
+		{"v1.2.x", "v1.2.5"},
+		{"v1.*", "v1.3.0"},
+		{"v1", "v1.3.0"},
+		{"*", "v3.0.0"},
+		{"v1 || v2", "v2.1.0"},
+		{"v1 || v2", "v1.1.0"},
+		{"^v1.2 || >=v3.0.0", "v3.1.0"},
+		{">v1.0.0;<v2.0.0 || =v3.0.0", "v3.0.0"},
+	}
+	notOk := [][2]string{
+		{"^v1.2", "v1.1.9"},
+		{"^v1.2", "v2.0.0"},
+		{"^v0.2.3", "v0.3.0"},
+		{"^v0.0.3", "v0.0.4"},
+		{"~v1.4.0", "v1.5.0"},
+		{"~v1.4.0", "v1.3.9"},
+		{"~v1", "v2.0.0"},
+		{"v1.x", "v2.0.0"},
+		{"v1.2.x", "v1.3.0"},
+		{"v1 || v2", "v3.0.0"},
+		{">v1.0.0;<v2.0.0 || =v3.0.0", "v2.5.0"},
+	}
+	notOkErr := [][2]string{
+		{"^test", "v1.0.0"},
+		{"~1.4.0", "v1.4.0"},
+		{"v1.x.3", "v1.0.3"},
+		{"v1.2.3.x", "v1.2.3"},
+		{"v1.2.3", "v1.2.3"},
+		{"v1 ||", "v1.0.0"},
+		{"|| v1", "v1.0.0"},
+		{"v1 || test", "v1.0.0"},
+		{"^v1.2;<v1.5.0", "v1.3.0"},
+		{"v1.x", "test"},
+	}
+	for _, v := range ok {
+		if k, err := InSemVerRange(v[0], v[1]); err != nil {
+			t.Errorf("InSemVerRange(\"%s\", \"%s\"); err != nil", v[0], v[1])
+		} else if k != true {
+			t.Errorf("InSemVerRange(\"%s\", \"%s\"); k != true", v[0], v[1])
+		}
+	}
+	for _, v := range notOk {
+		if k, err := InSemVerRange(v[0], v[1]); err != nil {
+			t.Errorf("InSemVerRange(\"%s\", \"%s\"); err != nil", v[0], v[1])
+		} else if k != false {
+			t.Errorf("InSemVerRange(\"%s\", \"%s\"); k != false", v[0], v[1])
+		}
+	}
+	for _, v := range notOkErr {
+		if _, err := InSemVerRange(v[0], v[1]); err == nil {
+			t.Errorf("InSemVerRange(\"%s\", \"%s\"); err == nil", v[0], v[1])
+		}
+	}
+}
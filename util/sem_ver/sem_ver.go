@@ -17,24 +17,24 @@
 package sem_ver
 
 import (
+	"errors"
 	"fmt"
 	"golang.org/x/mod/semver"
+	"sort"
 	"strings"
 )
 
+var ErrNoOverlap = errors.New("no overlap")
+
 func InSemVerRange(r string, v string) (bool, error) {
-	opr, ver, err := semVerRangeParse(r)
+	sr, err := parseRange(r)
 	if err != nil {
 		return false, err
 	}
 	if !semver.IsValid(v) {
 		return false, fmt.Errorf("format '%s' invalid", v)
 	}
-	ok := semVerRangeCheck(opr[0], ver[0], v)
-	if ok && len(opr) > 1 && len(ver) > 1 {
-		ok = semVerRangeCheck(opr[1], ver[1], v)
-	}
-	return ok, nil
+	return sr.check(v, false), nil
 }
 
 func IsValidSemVer(s string) bool {
@@ -42,7 +42,7 @@ func IsValidSemVer(s string) bool {
 }
 
 func ValidateSemVerRange(s string) error {
-	_, _, err := semVerRangeParse(s)
+	_, err := parseRange(s)
 	return err
 }
 
@@ -55,6 +55,77 @@ func CompareSemVer(v, w string) (int, error) {
 	return semver.Compare(v, w), nil
 }
 
+// SortSemVer sorts versions in ascending order.
+func SortSemVer(versions []string) error {
+	for _, v := range versions {
+		if !semver.IsValid(v) {
+			return fmt.Errorf("format '%s' invalid", v)
+		}
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return semver.Compare(versions[i], versions[j]) < 0
+	})
+	return nil
+}
+
+// MaxSatisfying returns the highest version that is in range r.
+func MaxSatisfying(versions []string, r string) (string, bool, error) {
+	return satisfying(versions, r, 1, false)
+}
+
+// MinSatisfying returns the lowest version that is in range r.
+func MinSatisfying(versions []string, r string) (string, bool, error) {
+	return satisfying(versions, r, -1, false)
+}
+
+// IntersectSemVerRange returns a range matching the versions matched by both a and b.
+func IntersectSemVerRange(a, b string) (string, error) {
+	ra, err := parseRange(a)
+	if err != nil {
+		return "", err
+	}
+	rb, err := parseRange(b)
+	if err != nil {
+		return "", err
+	}
+	var parts []string
+	seen := make(map[string]struct{})
+	for _, altA := range ra {
+		for _, altB := range rb {
+			i := newInterval(altA).intersect(newInterval(altB))
+			if i.empty() {
+				continue
+			}
+			s := i.String()
+			if _, ok := seen[s]; !ok {
+				parts = append(parts, s)
+				seen[s] = struct{}{}
+			}
+		}
+	}
+	if len(parts) == 0 {
+		return "", fmt.Errorf("ranges '%s' and '%s': %w", a, b, ErrNoOverlap)
+	}
+	return strings.Join(parts, " "+orSeparator+" "), nil
+}
+
+func satisfying(versions []string, r string, dir int, includePre bool) (string, bool, error) {
+	sr, err := parseRange(r)
+	if err != nil {
+		return "", false, err
+	}
+	var sel string
+	for _, v := range versions {
+		if !semver.IsValid(v) {
+			return "", false, fmt.Errorf("format '%s' invalid", v)
+		}
+		if sr.check(v, includePre) && (sel == "" || semver.Compare(v, sel) == dir) {
+			sel = v
+		}
+	}
+	return sel, sel != "", nil
+}
+
 func semVerRangeCheck(o string, w, v string) bool {
 	res := semver.Compare(v, w)
 	switch res {
@@ -17,6 +17,8 @@
 package sem_ver
 
 import (
+	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -136,3 +138,75 @@ func TestValidateSemVerRange(t *testing.T) {
 		t.Error("ValidateSemVerRange(\"test\"); err == nil")
 	}
 }
+
+func TestSortSemVer(t *testing.T) {
+	v := []string{"v1.10.0", "v1.2.0", "v1.2.0-beta", "v0.9.0", "v2"}
+	a := []string{"v0.9.0", "v1.2.0-beta", "v1.2.0", "v1.10.0", "v2"}
+	if err := SortSemVer(v); err != nil {
+		t.Error("err != nil")
+	}
+	if !reflect.DeepEqual(a, v) {
+		t.Errorf("%v != %v", a, v)
+	}
+	if err := SortSemVer([]string{"v1.0.0", "test"}); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestMaxSatisfying(t *testing.T) {
+	versions := []string{"v1.0.0", "v1.5.0", "v2.0.0", "v1.2.0"}
+	if v, ok, err := MaxSatisfying(versions, "^v1.1"); err != nil {
+		t.Error("err != nil")
+	} else if !ok || v != "v1.5.0" {
+		t.Errorf("%s != v1.5.0", v)
+	}
+	if v, ok, err := MinSatisfying(versions, "^v1.1"); err != nil {
+		t.Error("err != nil")
+	} else if !ok || v != "v1.2.0" {
+		t.Errorf("%s != v1.2.0", v)
+	}
+	if _, ok, err := MaxSatisfying(versions, ">v2.0.0"); err != nil {
+		t.Error("err != nil")
+	} else if ok {
+		t.Error("ok == true")
+	}
+	if _, _, err := MaxSatisfying(versions, "test"); err == nil {
+		t.Error("err == nil")
+	}
+	if _, _, err := MaxSatisfying([]string{"test"}, "v1"); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestIntersectSemVerRange(t *testing.T) {
+	ok := [][3]string{
+		{">=v1.0.0;<v2.0.0", ">v1.5.0", ">v1.5.0;<v2.0.0"},
+		{"^v1.2", "~v1.4.0", ">=v1.4.0;<v1.5.0"},
+		{"v1 || v3", ">=v1.5.0", ">=v1.5.0;<v2.0.0 || >=v3;<v4.0.0"},
+		{">=v1.0.0", "<=v1.0.0", "=v1.0.0"},
+		{"*", "<v2.0.0", "<v2.0.0"},
+		{"*", "*", "*"},
+	}
+	for _, v := range ok {
+		if r, err := IntersectSemVerRange(v[0], v[1]); err != nil {
+			t.Errorf("IntersectSemVerRange(\"%s\", \"%s\"); err != nil", v[0], v[1])
+		} else if r != v[2] {
+			t.Errorf("IntersectSemVerRange(\"%s\", \"%s\"); %s != %s", v[0], v[1], r, v[2])
+		} else if err = ValidateSemVerRange(r); err != nil {
+			t.Errorf("ValidateSemVerRange(\"%s\"); err != nil", r)
+		}
+	}
+	notOk := [][2]string{
+		{">=v2.0.0", "<v2.0.0"},
+		{">v1.0.0", "<=v1.0.0"},
+		{"v1 || v2", "v3"},
+	}
+	for _, v := range notOk {
+		if _, err := IntersectSemVerRange(v[0], v[1]); !errors.Is(err, ErrNoOverlap) {
+			t.Errorf("IntersectSemVerRange(\"%s\", \"%s\"); !errors.Is(err, ErrNoOverlap)", v[0], v[1])
+		}
+	}
+	if _, err := IntersectSemVerRange("test", "v1"); err == nil || errors.Is(err, ErrNoOverlap) {
+		t.Error("err == nil || errors.Is(err, ErrNoOverlap)")
+	}
+}
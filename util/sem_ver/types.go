@@ -0,0 +1,158 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sem_ver
+
+import (
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+type SemVer struct {
+	Major      int
+	Minor      int
+	Patch      int
+	Prerelease string // without leading '-'
+	Build      string // without leading '+'
+}
+
+// ParseSemVer parses a version like v1.2.3-rc.1+build.5, missing minor and patch components of shorthand versions like v1.2 are set to 0.
+func ParseSemVer(s string) (SemVer, error) {
+	_, c, err := parseComponents(s)
+	if err != nil {
+		return SemVer{}, err
+	}
+	return SemVer{
+		Major:      c[0],
+		Minor:      c[1],
+		Patch:      c[2],
+		Prerelease: strings.TrimPrefix(semver.Prerelease(s), "-"),
+		Build:      strings.TrimPrefix(semver.Build(s), "+"),
+	}, nil
+}
+
+func (v SemVer) String() string {
+	s := fmtVersion(v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// BumpMajor returns the next major version, a prerelease of a major version is bumped to its release.
+func (v SemVer) BumpMajor() SemVer {
+	if v.Prerelease == "" || v.Minor != 0 || v.Patch != 0 {
+		v.Major++
+	}
+	return SemVer{Major: v.Major}
+}
+
+// BumpMinor returns the next minor version, a prerelease of a minor version is bumped to its release.
+func (v SemVer) BumpMinor() SemVer {
+	if v.Prerelease == "" || v.Patch != 0 {
+		v.Minor++
+	}
+	return SemVer{Major: v.Major, Minor: v.Minor}
+}
+
+// BumpPatch returns the next patch version, a prerelease is bumped to its release.
+func (v SemVer) BumpPatch() SemVer {
+	if v.Prerelease == "" {
+		v.Patch++
+	}
+	return SemVer{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+}
+
+// Compare returns -1, 0 or 1 if v is less than, equal to or greater than w. Build metadata is ignored.
+func (v SemVer) Compare(w SemVer) int {
+	return semver.Compare(v.String(), w.String())
+}
+
+func (v SemVer) Equal(w SemVer) bool {
+	return v.Compare(w) == 0
+}
+
+func (v SemVer) LessThan(w SemVer) bool {
+	return v.Compare(w) < 0
+}
+
+func (v SemVer) GreaterThan(w SemVer) bool {
+	return v.Compare(w) > 0
+}
+
+func (v SemVer) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+func (v *SemVer) UnmarshalText(b []byte) error {
+	sv, err := ParseSemVer(string(b))
+	if err != nil {
+		return err
+	}
+	*v = sv
+	return nil
+}
+
+type Range struct {
+	raw string
+	r   semVerRange
+}
+
+func ParseRange(s string) (Range, error) {
+	r, err := parseRange(s)
+	if err != nil {
+		return Range{}, err
+	}
+	return Range{raw: s, r: r}, nil
+}
+
+func (r Range) String() string {
+	return r.raw
+}
+
+func (r Range) Contains(v SemVer) bool {
+	return r.r.check(v.String(), false)
+}
+
+func (r Range) Intersect(o Range) (Range, error) {
+	s, err := IntersectSemVerRange(r.raw, o.raw)
+	if err != nil {
+		return Range{}, err
+	}
+	return ParseRange(s)
+}
+
+func (r Range) MarshalText() ([]byte, error) {
+	return []byte(r.raw), nil
+}
+
+// UnmarshalText parses a range, an empty text is the zero value of an unset range.
+func (r *Range) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*r = Range{}
+		return nil
+	}
+	pr, err := ParseRange(string(b))
+	if err != nil {
+		return err
+	}
+	*r = pr
+	return nil
+}
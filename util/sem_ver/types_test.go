@@ -0,0 +1,151 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sem_ver
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseSemVer(t *testing.T) {
+	a := SemVer{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}
+	v, err := ParseSemVer("v1.2.3-rc.1+build.5")
+	if err != nil {
+		t.Error("err != nil")
+	}
+	if !reflect.DeepEqual(a, v) {
+		t.Errorf("%v != %v", a, v)
+	}
+	if v.String() != "v1.2.3-rc.1+build.5" {
+		t.Errorf("%s != v1.2.3-rc.1+build.5", v)
+	}
+	if v, err = ParseSemVer("v1.2"); err != nil {
+		t.Error("err != nil")
+	} else if v.String() != "v1.2.0" {
+		t.Errorf("%s != v1.2.0", v)
+	}
+	for _, s := range []string{"test", "1.2.3", "v1.2.3.4", ""} {
+		if _, err = ParseSemVer(s); err == nil {
+			t.Errorf("ParseSemVer(\"%s\"); err == nil", s)
+		}
+	}
+}
+
+func TestSemVer_Bump(t *testing.T) {
+	a := [][4]string{
+		// version, major, minor, patch
+		{"v1.2.3", "v2.0.0", "v1.3.0", "v1.2.4"},
+		{"v1.2.3-rc.1+build", "v2.0.0", "v1.3.0", "v1.2.3"},
+		{"v1.2.0-rc.1", "v2.0.0", "v1.2.0", "v1.2.0"},
+		{"v2.0.0-rc.1", "v2.0.0", "v2.0.0", "v2.0.0"},
+	}
+	for _, b := range a {
+		v, err := ParseSemVer(b[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := v.BumpMajor().String(); s != b[1] {
+			t.Errorf("%s.BumpMajor(); %s != %s", b[0], s, b[1])
+		}
+		if s := v.BumpMinor().String(); s != b[2] {
+			t.Errorf("%s.BumpMinor(); %s != %s", b[0], s, b[2])
+		}
+		if s := v.BumpPatch().String(); s != b[3] {
+			t.Errorf("%s.BumpPatch(); %s != %s", b[0], s, b[3])
+		}
+	}
+}
+
+func TestSemVer_Compare(t *testing.T) {
+	v1, _ := ParseSemVer("v1.2.3")
+	v2, _ := ParseSemVer("v1.10.0-beta")
+	v3, _ := ParseSemVer("v1.2.3+build")
+	if !v1.LessThan(v2) {
+		t.Error("v1.LessThan(v2) == false")
+	}
+	if !v2.GreaterThan(v1) {
+		t.Error("v2.GreaterThan(v1) == false")
+	}
+	if !v1.Equal(v3) {
+		t.Error("v1.Equal(v3) == false")
+	}
+}
+
+func TestSemVer_JSON(t *testing.T) {
+	type test struct {
+		Version SemVer `json:"version"`
+		Range   Range  `json:"range"`
+	}
+	var a test
+	if err := json.Unmarshal([]byte(`{"version":"v1.2.3-rc.1","range":"^v1.2 || v2.x"}`), &a); err != nil {
+		t.Fatal(err)
+	}
+	if a.Version.String() != "v1.2.3-rc.1" {
+		t.Errorf("%s != v1.2.3-rc.1", a.Version)
+	}
+	v, _ := ParseSemVer("v2.1.0")
+	if !a.Range.Contains(v) {
+		t.Error("a.Range.Contains(v) == false")
+	}
+	p, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(p) != `{"version":"v1.2.3-rc.1","range":"^v1.2 || v2.x"}` {
+		t.Errorf("%s != {\"version\":\"v1.2.3-rc.1\",\"range\":\"^v1.2 || v2.x\"}", p)
+	}
+	if err = json.Unmarshal([]byte(`{"version":"test"}`), &a); err == nil {
+		t.Error("err == nil")
+	}
+	if err = json.Unmarshal([]byte(`{"range":"test"}`), &a); err == nil {
+		t.Error("err == nil")
+	}
+	// ------------------------------
+	var b struct {
+		Range Range `json:"range"`
+	}
+	if p, err = json.Marshal(b); err != nil {
+		t.Fatal(err)
+	}
+	if string(p) != `{"range":""}` {
+		t.Errorf("%s != {\"range\":\"\"}", p)
+	}
+	b.Range, _ = ParseRange("v1")
+	if err = json.Unmarshal(p, &b); err != nil {
+		t.Error(err)
+	}
+	if !reflect.DeepEqual(Range{}, b.Range) {
+		t.Errorf("%v != %v", Range{}, b.Range)
+	}
+}
+
+func TestRange_Intersect(t *testing.T) {
+	r1, _ := ParseRange("^v1.2")
+	r2, _ := ParseRange("<v1.4.0")
+	r, err := r1.Intersect(r2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.String() != ">=v1.2;<v1.4.0" {
+		t.Errorf("%s != >=v1.2;<v1.4.0", r)
+	}
+	r2, _ = ParseRange("v2")
+	if _, err = r1.Intersect(r2); err == nil {
+		t.Error("err == nil")
+	}
+}
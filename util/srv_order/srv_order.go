@@ -0,0 +1,73 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package srv_order
+
+import (
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"github.com/SENERGY-Platform/mgw-module-lib/util/tsort"
+)
+
+const AuxPrefix = "aux:"
+
+type Order struct {
+	Start  []string   // services in start order
+	Stop   []string   // services in stop order
+	Stages [][]string // services of a stage can be started in parallel
+}
+
+// GetNodes returns a graph where services require the services they reference. Aux services are added with AuxPrefix.
+func GetNodes(services map[string]model.Service, auxServices map[string]model.AuxService) tsort.Nodes {
+	nodes := make(tsort.Nodes)
+	for ref, srv := range services {
+		nodes.Add(ref, getInRefs(srv.SrvReferences, services), nil)
+	}
+	for ref, srv := range auxServices {
+		nodes.Add(AuxPrefix+ref, getInRefs(srv.SrvReferences, services), nil)
+	}
+	return nodes
+}
+
+func GetOrder(services map[string]model.Service, auxServices map[string]model.AuxService) (Order, error) {
+	nodes := GetNodes(services, auxServices)
+	stages, err := tsort.GetTopStages(nodes)
+	if err != nil {
+		return Order{}, err
+	}
+	var start []string
+	for _, stage := range stages {
+		start = append(start, stage...)
+	}
+	stop := make([]string, len(start))
+	for i, id := range start {
+		stop[len(start)-1-i] = id
+	}
+	return Order{
+		Start:  start,
+		Stop:   stop,
+		Stages: stages,
+	}, nil
+}
+
+func getInRefs(sReferences map[string]model.SrvRefTarget, services map[string]model.Service) map[string]struct{} {
+	inRefs := make(map[string]struct{})
+	for _, target := range sReferences {
+		if _, ok := services[target.Ref]; ok {
+			inRefs[target.Ref] = struct{}{}
+		}
+	}
+	return inRefs
+}
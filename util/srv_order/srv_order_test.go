@@ -0,0 +1,85 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package srv_order
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"github.com/SENERGY-Platform/mgw-module-lib/util/tsort"
+)
+
+func TestGetNodes(t *testing.T) {
+	services := map[string]model.Service{
+		"a": {SrvReferences: map[string]model.SrvRefTarget{"B": {Ref: "b"}, "C": {Ref: "c"}}},
+		"b": {},
+	}
+	auxServices := map[string]model.AuxService{
+		"x": {SrvReferences: map[string]model.SrvRefTarget{"A": {Ref: "a"}}},
+	}
+	nodes := GetNodes(services, auxServices)
+	if len(nodes) != 3 {
+		t.Errorf("len(%v) != 3", nodes)
+	}
+	if _, ok := nodes["a"].InRef["b"]; !ok {
+		t.Error("_, ok := nodes[\"a\"].InRef[\"b\"]; !ok")
+	}
+	if _, ok := nodes["a"].InRef["c"]; ok {
+		t.Error("_, ok := nodes[\"a\"].InRef[\"c\"]; ok")
+	}
+	if _, ok := nodes[AuxPrefix+"x"].InRef["a"]; !ok {
+		t.Errorf("_, ok := nodes[\"%sx\"].InRef[\"a\"]; !ok", AuxPrefix)
+	}
+}
+
+func TestGetOrder(t *testing.T) {
+	services := map[string]model.Service{
+		"web": {SrvReferences: map[string]model.SrvRefTarget{"API": {Ref: "api"}}},
+		"api": {SrvReferences: map[string]model.SrvRefTarget{"DB": {Ref: "db"}, "MQ": {Ref: "mq"}}},
+		"db":  {},
+		"mq":  {},
+	}
+	o, err := GetOrder(services, nil)
+	if err != nil {
+		t.Fatal("err != nil")
+	}
+	a := []string{"db", "mq", "api", "web"}
+	if !reflect.DeepEqual(a, o.Start) {
+		t.Errorf("%v != %v", a, o.Start)
+	}
+	a = []string{"web", "api", "mq", "db"}
+	if !reflect.DeepEqual(a, o.Stop) {
+		t.Errorf("%v != %v", a, o.Stop)
+	}
+	a1 := [][]string{{"db", "mq"}, {"api"}, {"web"}}
+	if !reflect.DeepEqual(a1, o.Stages) {
+		t.Errorf("%v != %v", a1, o.Stages)
+	}
+	// ------------------------------
+	services["db"] = model.Service{SrvReferences: map[string]model.SrvRefTarget{"WEB": {Ref: "web"}}}
+	_, err = GetOrder(services, nil)
+	var cErr *tsort.CycleError
+	if !errors.As(err, &cErr) {
+		t.Fatal("!errors.As(err, &cErr)")
+	}
+	a2 := [][]string{{"api", "db", "web", "api"}}
+	if !reflect.DeepEqual(a2, cErr.Cycles) {
+		t.Errorf("%v != %v", a2, cErr.Cycles)
+	}
+}
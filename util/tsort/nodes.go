@@ -74,3 +74,64 @@ func (n Nodes) Copy() Nodes {
 	}
 	return cNodes
 }
+
+// Ancestors returns all nodes the node transitively requires.
+func (n Nodes) Ancestors(id string) map[string]struct{} {
+	return n.walk(id, func(nde *node) map[string]struct{} {
+		return nde.InRef
+	})
+}
+
+// Descendants returns all nodes transitively requiring the node.
+func (n Nodes) Descendants(id string) map[string]struct{} {
+	return n.walk(id, func(nde *node) map[string]struct{} {
+		return nde.OutRef
+	})
+}
+
+// Subgraph returns a copy containing only the given nodes and the references between them.
+func (n Nodes) Subgraph(ids map[string]struct{}) Nodes {
+	sNodes := make(Nodes)
+	for id := range ids {
+		nde, ok := n[id]
+		if !ok {
+			continue
+		}
+		sNde := newNode()
+		for ref := range nde.InRef {
+			if _, k := ids[ref]; k {
+				sNde.AddInRef(ref)
+			}
+		}
+		for ref := range nde.OutRef {
+			if _, k := ids[ref]; k {
+				sNde.AddOutRef(ref)
+			}
+		}
+		sNodes[id] = sNde
+	}
+	return sNodes
+}
+
+func (n Nodes) walk(id string, refsFunc func(nde *node) map[string]struct{}) map[string]struct{} {
+	visited := make(map[string]struct{})
+	nde, ok := n[id]
+	if !ok {
+		return visited
+	}
+	queue := []*node{nde}
+	for len(queue) > 0 {
+		nde = queue[0]
+		queue = queue[1:]
+		for ref := range refsFunc(nde) {
+			if _, k := visited[ref]; k {
+				continue
+			}
+			visited[ref] = struct{}{}
+			if rNde, k := n[ref]; k {
+				queue = append(queue, rNde)
+			}
+		}
+	}
+	return visited
+}
@@ -121,3 +121,61 @@ func TestNodes_Copy(t *testing.T) {
 		t.Error("copy not equal to original")
 	}
 }
+
+func genTestNodes() Nodes {
+	n := make(Nodes)
+	// add node "B" which requires node "A" (A -> B)
+	n.Add("B", map[string]struct{}{"A": {}}, nil)
+	// add node "C" which requires node "B" (B -> C)
+	n.Add("C", map[string]struct{}{"B": {}}, nil)
+	// add node "D" which requires node "A" (A -> D)
+	n.Add("D", map[string]struct{}{"A": {}}, nil)
+	// add node "E"
+	n.Add("E", nil, nil)
+	return n
+}
+
+func TestNodes_Ancestors(t *testing.T) {
+	n := genTestNodes()
+	a := map[string]struct{}{"A": {}, "B": {}}
+	if b := n.Ancestors("C"); !reflect.DeepEqual(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+	if b := n.Ancestors("A"); len(b) != 0 {
+		t.Errorf("len(%v) != 0", b)
+	}
+	if b := n.Ancestors("X"); len(b) != 0 {
+		t.Errorf("len(%v) != 0", b)
+	}
+}
+
+func TestNodes_Descendants(t *testing.T) {
+	n := genTestNodes()
+	a := map[string]struct{}{"B": {}, "C": {}, "D": {}}
+	if b := n.Descendants("A"); !reflect.DeepEqual(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+	if b := n.Descendants("E"); len(b) != 0 {
+		t.Errorf("len(%v) != 0", b)
+	}
+	// add node "A" which requires node "C" (C -> A)
+	n.Add("A", map[string]struct{}{"C": {}}, nil)
+	a = map[string]struct{}{"A": {}, "B": {}, "C": {}, "D": {}}
+	if b := n.Descendants("A"); !reflect.DeepEqual(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+}
+
+func TestNodes_Subgraph(t *testing.T) {
+	n := genTestNodes()
+	s := n.Subgraph(map[string]struct{}{"A": {}, "B": {}, "E": {}, "X": {}})
+	a := make(Nodes)
+	a.Add("B", map[string]struct{}{"A": {}}, nil)
+	a.Add("E", nil, nil)
+	if !reflect.DeepEqual(a, s) {
+		t.Errorf("%v != %v", a, s)
+	}
+	if _, ok := n["A"].OutRef["D"]; !ok {
+		t.Error("original modified")
+	}
+}
@@ -17,48 +17,199 @@
 package tsort
 
 import (
-	"fmt"
 	"sort"
 	"strings"
 )
 
+type CycleError struct {
+	Cycles [][]string // e.g. [[A B C A]] for A requires B, B requires C and C requires A
+}
+
+func (e *CycleError) Error() string {
+	var cycles []string
+	for _, cycle := range e.Cycles {
+		cycles = append(cycles, strings.Join(cycle, " -> "))
+	}
+	return "non acyclic graph: " + strings.Join(cycles, ", ")
+}
+
 func GetTopOrder(nodes Nodes) ([]string, error) {
-	return topSort(nodes.Copy(), nil)
+	stages, err := GetTopStages(nodes)
+	if err != nil {
+		return nil, err
+	}
+	var order []string
+	for _, stage := range stages {
+		order = append(order, stage...)
+	}
+	return order, nil
 }
 
-func topSort(nodes Nodes, stack []string) ([]string, error) {
-	del := false
+// GetSubTopOrder returns the order of the given nodes, requirements via nodes not included are respected.
+func GetSubTopOrder(nodes Nodes, ids map[string]struct{}) ([]string, error) {
+	sIds := make(map[string]struct{})
+	for id := range ids {
+		if _, ok := nodes[id]; !ok {
+			continue
+		}
+		sIds[id] = struct{}{}
+		for ref := range nodes.Ancestors(id) {
+			sIds[ref] = struct{}{}
+		}
+	}
+	order, err := GetTopOrder(nodes.Subgraph(sIds))
+	if err != nil {
+		return nil, err
+	}
+	var sOrder []string
+	for _, id := range order {
+		if _, ok := ids[id]; ok {
+			sOrder = append(sOrder, id)
+		}
+	}
+	return sOrder, nil
+}
+
+// GetTopStages returns the nodes grouped in stages, all requirements of a node are part of previous stages.
+func GetTopStages(nodes Nodes) ([][]string, error) {
+	inDeg := make(map[string]int)
+	var stage []string
 	for ndeId, nde := range nodes {
-		if len(nde.InRef) == 0 && len(nde.OutRef) > 0 {
-			stack = append(stack, ndeId)
-			del = true
-			break
+		inDeg[ndeId] = len(nde.InRef)
+		if len(nde.InRef) == 0 {
+			stage = append(stage, ndeId)
 		}
 	}
-	if del {
-		nodes.Remove(stack[len(stack)-1])
-		return topSort(nodes, stack)
-	} else {
-		var errStr []string
-		for ndeId, nde := range nodes {
-			if len(nde.InRef) != 0 {
-				errStr = append(errStr, fmt.Sprintf("[%s->%s->%s]", keysToStr(nde.InRef), ndeId, keysToStr(nde.OutRef)))
+	var stages [][]string
+	for len(stage) > 0 {
+		sort.Strings(stage)
+		stages = append(stages, stage)
+		var next []string
+		for _, ndeId := range stage {
+			delete(inDeg, ndeId)
+			for ref := range nodes[ndeId].OutRef {
+				inDeg[ref]--
+				if inDeg[ref] == 0 {
+					next = append(next, ref)
+				}
+			}
+		}
+		stage = next
+	}
+	if len(inDeg) > 0 {
+		remaining := make(map[string]struct{})
+		for ndeId := range inDeg {
+			remaining[ndeId] = struct{}{}
+		}
+		return nil, &CycleError{Cycles: findCycles(nodes, remaining)}
+	}
+	return stages, nil
+}
+
+type dfsFrame struct {
+	id   string
+	refs []string
+	pos  int
+}
+
+// findCycles determines the strongly connected components of the remaining nodes and returns the shortest cycle of each component.
+func findCycles(nodes Nodes, remaining map[string]struct{}) [][]string {
+	visited := make(map[string]struct{})
+	var finished []string
+	for _, id := range sortedKeys(remaining, nil) {
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = struct{}{}
+		stack := []dfsFrame{{id: id, refs: sortedKeys(nodes[id].OutRef, remaining)}}
+		for len(stack) > 0 {
+			frame := &stack[len(stack)-1]
+			if frame.pos < len(frame.refs) {
+				ref := frame.refs[frame.pos]
+				frame.pos++
+				if _, ok := visited[ref]; !ok {
+					visited[ref] = struct{}{}
+					stack = append(stack, dfsFrame{id: ref, refs: sortedKeys(nodes[ref].OutRef, remaining)})
+				}
 			} else {
-				stack = append(stack, ndeId)
+				finished = append(finished, frame.id)
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+	assigned := make(map[string]struct{})
+	var cycles [][]string
+	for i := len(finished) - 1; i >= 0; i-- {
+		id := finished[i]
+		if _, ok := assigned[id]; ok {
+			continue
+		}
+		assigned[id] = struct{}{}
+		component := map[string]struct{}{id: {}}
+		queue := []string{id}
+		for len(queue) > 0 {
+			ndeId := queue[0]
+			queue = queue[1:]
+			for ref := range nodes[ndeId].InRef {
+				if _, ok := remaining[ref]; !ok {
+					continue
+				}
+				if _, ok := assigned[ref]; !ok {
+					assigned[ref] = struct{}{}
+					component[ref] = struct{}{}
+					queue = append(queue, ref)
+				}
 			}
 		}
-		if len(errStr) > 0 {
-			return nil, fmt.Errorf("non acyclic graph: %v", strings.Join(errStr, " "))
+		if _, ok := nodes[id].OutRef[id]; len(component) > 1 || ok {
+			cycles = append(cycles, shortestCycle(nodes, component))
 		}
 	}
-	return stack, nil
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i][0] < cycles[j][0]
+	})
+	return cycles
 }
 
-func keysToStr(m map[string]struct{}) string {
+// shortestCycle returns the shortest cycle along the requirements starting at the lexically smallest node of a component.
+func shortestCycle(nodes Nodes, component map[string]struct{}) []string {
+	start := sortedKeys(component, nil)[0]
+	parent := make(map[string]string)
+	queue := []string{start}
+	for len(queue) > 0 {
+		ndeId := queue[0]
+		queue = queue[1:]
+		for _, ref := range sortedKeys(nodes[ndeId].InRef, component) {
+			if ref == start {
+				path := []string{start}
+				for id := ndeId; id != start; id = parent[id] {
+					path = append(path, id)
+				}
+				path = append(path, start)
+				for l, r := 1, len(path)-2; l < r; l, r = l+1, r-1 {
+					path[l], path[r] = path[r], path[l]
+				}
+				return path
+			}
+			if _, ok := parent[ref]; !ok {
+				parent[ref] = ndeId
+				queue = append(queue, ref)
+			}
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]struct{}, filter map[string]struct{}) []string {
 	var keys []string
 	for key := range m {
+		if filter != nil {
+			if _, ok := filter[key]; !ok {
+				continue
+			}
+		}
 		keys = append(keys, key)
 	}
 	sort.Strings(keys)
-	return strings.Join(keys, ",")
+	return keys
 }
@@ -17,7 +17,9 @@
 package tsort
 
 import (
+	"errors"
 	"reflect"
+	"strconv"
 	"testing"
 )
 
@@ -156,3 +158,123 @@ func TestGetTopOrder(t *testing.T) {
 		t.Error("err == nil")
 	}
 }
+
+func TestGetTopStages(t *testing.T) {
+	n := make(Nodes)
+	if s, err := GetTopStages(n); err != nil {
+		t.Error("err != nil")
+	} else if len(s) != 0 {
+		t.Errorf("len(%v) != 0", s)
+	}
+	// add node "A" which requires node "C" and "D" (C -> A, D -> A)
+	n.Add("A", map[string]struct{}{"C": {}, "D": {}}, nil)
+	// add node "B" which requires node "C" (C -> B)
+	n.Add("B", map[string]struct{}{"C": {}}, nil)
+	// add node "E" which requires node "A" and "B" (A -> E, B -> E)
+	n.Add("E", map[string]struct{}{"A": {}, "B": {}}, nil)
+	// add node "F"
+	n.Add("F", nil, nil)
+	a := [][]string{{"C", "D", "F"}, {"A", "B"}, {"E"}}
+	for i := 0; i < 10; i++ {
+		if s, err := GetTopStages(n); err != nil {
+			t.Error("err != nil")
+		} else if !reflect.DeepEqual(a, s) {
+			t.Errorf("%v != %v", a, s)
+		}
+	}
+	if len(n) != 6 {
+		t.Error("nodes modified")
+	}
+	// add node "C" which requires node "E" (E -> C)
+	n.Add("C", map[string]struct{}{"E": {}}, nil)
+	if _, err := GetTopStages(n); err == nil {
+		t.Error("err == nil")
+	}
+	n = make(Nodes)
+	// add node "A" which requires node "A" (A -> A)
+	n.Add("A", map[string]struct{}{"A": {}}, nil)
+	if _, err := GetTopStages(n); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestCycleError(t *testing.T) {
+	n := make(Nodes)
+	// add node "B" which requires node "A" and "D" (A -> B, D -> B)
+	n.Add("B", map[string]struct{}{"A": {}, "D": {}}, nil)
+	// add node "C" which requires node "B" (B -> C)
+	n.Add("C", map[string]struct{}{"B": {}}, nil)
+	// add node "A" which requires node "C" (C -> A)
+	n.Add("A", map[string]struct{}{"C": {}}, nil)
+	// add node "D" which requires node "A" (A -> D)
+	n.Add("D", map[string]struct{}{"A": {}}, nil)
+	// add node "E" which requires node "C" (C -> E)
+	n.Add("E", map[string]struct{}{"C": {}}, nil)
+	// add node "F" which requires node "F" (F -> F)
+	n.Add("F", map[string]struct{}{"F": {}}, nil)
+	// add node "G"
+	n.Add("G", nil, nil)
+	_, err := GetTopOrder(n)
+	var cErr *CycleError
+	if !errors.As(err, &cErr) {
+		t.Fatal("!errors.As(err, &cErr)")
+	}
+	// cycles follow the requirements (A requires C, C requires B, B requires A)
+	a := [][]string{{"A", "C", "B", "A"}, {"F", "F"}}
+	if !reflect.DeepEqual(a, cErr.Cycles) {
+		t.Errorf("%v != %v", a, cErr.Cycles)
+	}
+	if s := "non acyclic graph: A -> C -> B -> A, F -> F"; cErr.Error() != s {
+		t.Errorf("\"%s\" != \"%s\"", cErr.Error(), s)
+	}
+}
+
+func TestGetTopOrderLarge(t *testing.T) {
+	n := make(Nodes)
+	num := 10000
+	for i := 1; i < num; i++ {
+		n.Add(strconv.Itoa(i), map[string]struct{}{strconv.Itoa(i - 1): {}}, nil)
+	}
+	o, err := GetTopOrder(n)
+	if err != nil {
+		t.Fatal("err != nil")
+	}
+	if len(o) != num {
+		t.Errorf("len(o) != %d", num)
+	}
+	for i, id := range o {
+		if id != strconv.Itoa(i) {
+			t.Fatalf("%s != %d", id, i)
+		}
+	}
+	n.Add("0", map[string]struct{}{strconv.Itoa(num - 1): {}}, nil)
+	_, err = GetTopOrder(n)
+	var cErr *CycleError
+	if !errors.As(err, &cErr) {
+		t.Fatal("!errors.As(err, &cErr)")
+	}
+	if len(cErr.Cycles) != 1 || len(cErr.Cycles[0]) != num+1 {
+		t.Error("invalid cycle")
+	}
+}
+
+func TestGetSubTopOrder(t *testing.T) {
+	n := make(Nodes)
+	// add node "X" which requires node "C" (C -> X)
+	n.Add("X", map[string]struct{}{"C": {}}, nil)
+	// add node "A" which requires node "X" (X -> A)
+	n.Add("A", map[string]struct{}{"X": {}}, nil)
+	// add node "B" which requires node "A" (A -> B)
+	n.Add("B", map[string]struct{}{"A": {}}, nil)
+	// add node "Y" which requires node "Y" (Y -> Y)
+	n.Add("Y", map[string]struct{}{"Y": {}}, nil)
+	a := []string{"C", "A", "B"}
+	if o, err := GetSubTopOrder(n, map[string]struct{}{"A": {}, "B": {}, "C": {}}); err != nil {
+		t.Error("err != nil")
+	} else if !reflect.DeepEqual(a, o) {
+		t.Errorf("%v != %v", a, o)
+	}
+	if _, err := GetSubTopOrder(n, map[string]struct{}{"A": {}, "Y": {}}); err == nil {
+		t.Error("err == nil")
+	}
+}
@@ -0,0 +1,153 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validation
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"github.com/SENERGY-Platform/mgw-module-lib/util/img_ref"
+)
+
+const maxSignal = 64
+
+var stopSignals = map[string]struct{}{
+	"SIGABRT":  {},
+	"SIGHUP":   {},
+	"SIGINT":   {},
+	"SIGKILL":  {},
+	"SIGQUIT":  {},
+	"SIGTERM":  {},
+	"SIGUSR1":  {},
+	"SIGUSR2":  {},
+	"SIGWINCH": {},
+}
+
+// ValidateAuxDeployment checks a deployment request against the module and returns the resolved container spec.
+func ValidateAuxDeployment(m model.Module, req model.AuxDeploymentRequest) (model.AuxContainerSpec, error) {
+	var errs issues
+	auxService, ok := m.AuxServices[req.Ref]
+	if !ok {
+		errs.addf("ref", CodeUndefinedReference, "aux service '%s' not defined", req.Ref)
+		return model.AuxContainerSpec{}, errs.err()
+	}
+	spec := model.AuxContainerSpec{
+		Ref:             req.Ref,
+		Name:            auxService.Name,
+		Image:           req.Image,
+		RunConfig:       mergeRunConfigs(auxService.RunConfig, req.RunConfig),
+		BindMounts:      maps.Clone(auxService.BindMounts),
+		Tmpfs:           maps.Clone(auxService.Tmpfs),
+		Volumes:         mergeMaps(auxService.Volumes, req.Volumes),
+		Configs:         mergeMaps(auxService.Configs, req.Configs),
+		SrvReferences:   mergeMaps(auxService.SrvReferences, req.SrvReferences),
+		ExtDependencies: mergeMaps(auxService.ExtDependencies, req.ExtDependencies),
+	}
+	errs.merge("image", validateAuxImage(req.Image, m.AuxImgSrc))
+	errs.merge("run_config", validateRunConfig(spec.RunConfig))
+	refVars := make(map[string]struct{})
+	mntPts := make(map[string]struct{})
+	errs.merge("bind_mounts", validateMapKeys(spec.BindMounts, mntPts))
+	errs.merge("tmpfs", validateMapKeys(spec.Tmpfs, mntPts))
+	errs.merge("volumes", validateMapKeys(spec.Volumes, mntPts))
+	errs.merge("configs", validateMapKeys(spec.Configs, refVars))
+	errs.merge("srv_references", validateMapKeys(spec.SrvReferences, refVars))
+	errs.merge("ext_dependencies", validateMapKeys(spec.ExtDependencies, refVars))
+	errs.merge("volumes", validateServiceVolumes(spec.Volumes, m.Volumes))
+	errs.merge("configs", validateServiceConfigs(spec.Configs, m.Configs))
+	errs.merge("srv_references", validateServiceReferences(spec.SrvReferences, m.Services))
+	errs.merge("ext_dependencies", validateServiceExternalDependencies(spec.ExtDependencies, m.Dependencies))
+	if err := errs.err(); err != nil {
+		return model.AuxContainerSpec{}, err
+	}
+	return spec, nil
+}
+
+func validateAuxImage(image string, imgSources map[string]struct{}) error {
+	var errs issues
+	ref, err := img_ref.Parse(image)
+	if err != nil {
+		errs.addf("", CodeInvalidFormat, "%s", err)
+		return errs.err()
+	}
+	matcher, err := img_ref.NewMatcher(imgSources)
+	if err != nil {
+		errs.addf("", CodeInvalidValue, "%s", err)
+		return errs.err()
+	}
+	if !matcher.MatchRef(ref) {
+		errs.addf("", CodeInvalidValue, "image '%s' not allowed", image)
+	}
+	return errs.err()
+}
+
+func validateRunConfig(rc model.RunConfig) error {
+	var errs issues
+	if rc.StopTimeout < 0 {
+		errs.addf("stop_timeout", CodeInvalidValue, "negative stop timeout '%s'", rc.StopTimeout)
+	}
+	if rc.StopSignal != "" && !isValidStopSignal(rc.StopSignal) {
+		errs.addf("stop_signal", CodeInvalidValue, "invalid stop signal '%s'", rc.StopSignal)
+	}
+	if len(rc.Command) > 0 && rc.Command[0] == "" {
+		errs.addf("command", CodeInvalidValue, "empty command")
+	}
+	return errs.err()
+}
+
+// isValidStopSignal checks if s is a known signal name, with or without SIG prefix, or a signal number.
+func isValidStopSignal(s string) bool {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n > 0 && n <= maxSignal
+	}
+	_, ok := stopSignals["SIG"+strings.TrimPrefix(strings.ToUpper(s), "SIG")]
+	return ok
+}
+
+// mergeRunConfigs overrides the fields of rc that are set in o, zero values keep the value of rc.
+func mergeRunConfigs(rc model.RunConfig, o *model.RunConfig) model.RunConfig {
+	rc.Command = slices.Clone(rc.Command)
+	if o == nil {
+		return rc
+	}
+	if o.StopTimeout != 0 {
+		rc.StopTimeout = o.StopTimeout
+	}
+	if o.StopSignal != "" {
+		rc.StopSignal = o.StopSignal
+	}
+	if o.PseudoTTY {
+		rc.PseudoTTY = true
+	}
+	if len(o.Command) > 0 {
+		rc.Command = slices.Clone(o.Command)
+	}
+	return rc
+}
+
+func mergeMaps[T any](a, b map[string]T) map[string]T {
+	if a == nil && b == nil {
+		return nil
+	}
+	m := make(map[string]T)
+	maps.Copy(m, a)
+	maps.Copy(m, b)
+	return m
+}
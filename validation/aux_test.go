@@ -0,0 +1,125 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+func genAuxTestModule() model.Module {
+	str := "test"
+	m := model.Module{
+		ID:           "test.test/test",
+		Version:      "v1.0.0",
+		Volumes:      map[string]struct{}{"data": {}, "cache": {}},
+		Dependencies: map[string]string{"test.test/dep": ">=v1.0.0"},
+		Services:     map[string]model.Service{"api": {}},
+		Configs:      make(model.Configs),
+		AuxServices: map[string]model.AuxService{
+			"job": {
+				Name:       "Job",
+				RunConfig:  model.RunConfig{StopTimeout: time.Second, Command: []string{"run"}},
+				BindMounts: map[string]model.BindMount{"/etc/job": {Source: "job"}},
+				Volumes:    map[string]string{"/data": "data"},
+				Configs:    map[string]string{"VAR_A": "a"},
+			},
+		},
+		AuxImgSrc: map[string]struct{}{"ghcr.io/senergy-platform/*": {}, "nginx": {}},
+	}
+	m.Configs.SetString("a", &str, nil, false, "", nil, false)
+	m.Configs.SetString("b", &str, nil, false, "", nil, false)
+	return m
+}
+
+func TestValidateAuxDeployment(t *testing.T) {
+	m := genAuxTestModule()
+	req := model.AuxDeploymentRequest{
+		Ref:             "job",
+		Image:           "ghcr.io/senergy-platform/job:v1",
+		Volumes:         map[string]string{"/cache": "cache"},
+		Configs:         map[string]string{"VAR_B": "b"},
+		SrvReferences:   map[string]model.SrvRefTarget{"API": {Ref: "api"}},
+		ExtDependencies: map[string]model.ExtDependencyTarget{"DEP": {ID: "test.test/dep", Service: "x"}},
+	}
+	a := model.AuxContainerSpec{
+		Ref:             "job",
+		Name:            "Job",
+		Image:           "ghcr.io/senergy-platform/job:v1",
+		RunConfig:       model.RunConfig{StopTimeout: time.Second, Command: []string{"run"}},
+		BindMounts:      map[string]model.BindMount{"/etc/job": {Source: "job"}},
+		Volumes:         map[string]string{"/data": "data", "/cache": "cache"},
+		Configs:         map[string]string{"VAR_A": "a", "VAR_B": "b"},
+		SrvReferences:   map[string]model.SrvRefTarget{"API": {Ref: "api"}},
+		ExtDependencies: map[string]model.ExtDependencyTarget{"DEP": {ID: "test.test/dep", Service: "x"}},
+	}
+	spec, err := ValidateAuxDeployment(m, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(a, spec) {
+		t.Errorf("%v != %v", a, spec)
+	}
+	spec.BindMounts["/etc/test"] = model.BindMount{}
+	spec.RunConfig.Command[0] = "test"
+	if len(m.AuxServices["job"].Volumes) != 1 || len(m.AuxServices["job"].BindMounts) != 1 || m.AuxServices["job"].RunConfig.Command[0] != "run" {
+		t.Error("aux service modified")
+	}
+	// ------------------------------
+	req = model.AuxDeploymentRequest{
+		Ref:       "job",
+		Image:     "nginx:1.25",
+		RunConfig: &model.RunConfig{StopSignal: "term"},
+	}
+	rc := model.RunConfig{StopTimeout: time.Second, StopSignal: "term", Command: []string{"run"}}
+	if spec, err = ValidateAuxDeployment(m, req); err != nil {
+		t.Error("err != nil")
+	} else if !reflect.DeepEqual(rc, spec.RunConfig) {
+		t.Errorf("%v != %v", rc, spec.RunConfig)
+	}
+	req.RunConfig = &model.RunConfig{StopSignal: "15", Command: []string{"exec"}}
+	rc = model.RunConfig{StopTimeout: time.Second, StopSignal: "15", Command: []string{"exec"}}
+	if spec, err = ValidateAuxDeployment(m, req); err != nil {
+		t.Error("err != nil")
+	} else if !reflect.DeepEqual(rc, spec.RunConfig) {
+		t.Errorf("%v != %v", rc, spec.RunConfig)
+	}
+	// ------------------------------
+	bad := []model.AuxDeploymentRequest{
+		{Ref: "test", Image: "nginx"},
+		{Ref: "job", Image: "redis"},
+		{Ref: "job", Image: "nginx:latest:1"},
+		{Ref: "job", Image: "nginx", Volumes: map[string]string{"/test": "test"}},
+		{Ref: "job", Image: "nginx", Configs: map[string]string{"VAR_B": "test"}},
+		{Ref: "job", Image: "nginx", SrvReferences: map[string]model.SrvRefTarget{"X": {Ref: "test"}}},
+		{Ref: "job", Image: "nginx", ExtDependencies: map[string]model.ExtDependencyTarget{"X": {ID: "test"}}},
+		{Ref: "job", Image: "nginx", SrvReferences: map[string]model.SrvRefTarget{"VAR_A": {Ref: "api"}}},
+		{Ref: "job", Image: "nginx", RunConfig: &model.RunConfig{StopTimeout: -1}},
+		{Ref: "job", Image: "nginx", RunConfig: &model.RunConfig{StopSignal: "test"}},
+		{Ref: "job", Image: "nginx", RunConfig: &model.RunConfig{StopSignal: "0"}},
+		{Ref: "job", Image: "nginx", RunConfig: &model.RunConfig{StopSignal: "65"}},
+		{Ref: "job", Image: "nginx", RunConfig: &model.RunConfig{Command: []string{"", "a"}}},
+	}
+	for i, r := range bad {
+		if _, err = ValidateAuxDeployment(m, r); err == nil {
+			t.Errorf("%d: err == nil", i)
+		}
+	}
+}
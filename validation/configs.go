@@ -21,24 +21,25 @@ import (
 	"strings"
 
 	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs"
 	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs/definitions"
 	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs/validators"
 )
 
-func validateTypeOptionsBase(cType string, cTypeOpts model.ConfigTypeOptions, dataType model.DataType) error {
-	cDef, ok := definitions.Definitions[cType]
-	if !ok {
-		return fmt.Errorf("config type '%s' not defined", cType)
+func validateTypeOptionsBase(reg *configs.Registry, cType string, cTypeOpts model.ConfigTypeOptions, dataType model.DataType) error {
+	cDef, _, err := reg.Lookup(cType)
+	if err != nil {
+		return err
 	}
 	return vltBase(cDef, cTypeOpts, dataType)
 }
 
-func validateTypeOptions(cType string, cTypeOpts model.ConfigTypeOptions) error {
-	cDef, ok := definitions.Definitions[cType]
-	if !ok {
-		return fmt.Errorf("config type '%s' not defined", cType)
+func validateTypeOptions(reg *configs.Registry, cType string, cTypeOpts model.ConfigTypeOptions) error {
+	cDef, vlts, err := reg.Lookup(cType)
+	if err != nil {
+		return err
 	}
-	return vltTypeOpts(cDef.Validators, cTypeOpts, validators.Validators)
+	return vltTypeOpts(cDef.Validators, cTypeOpts, vlts)
 }
 
 func vltBase(cDef definitions.ConfigDefinition, cTypeOpts model.ConfigTypeOptions, dataType model.DataType) error {
@@ -106,7 +107,7 @@ func genVltOptParams(cDefVltParams map[string]definitions.ConfigDefinitionValida
 				} else {
 					if cDefVP.Value != nil {
 						vp[name] = cDefVP.Value
-					} else {
+					} else if !cDefVP.Optional {
 						vp = nil
 						break
 					}
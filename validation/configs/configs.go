@@ -26,24 +26,19 @@ import (
 )
 
 func ValidateValue(cType string, cTypeOpts model.ConfigTypeOptions, value any) error {
-	cDef, ok := definitions.Definitions[cType]
-	if !ok {
-		return fmt.Errorf("config type '%s' not defined", cType)
+	r, err := DefaultRegistry()
+	if err != nil {
+		return err
 	}
-	return vltValue(cDef.Validators, cTypeOpts, validators.Validators, value)
+	return r.ValidateValue(cType, cTypeOpts, value)
 }
 
 func ValidateValueSlice[T any](cType string, cTypeOpts model.ConfigTypeOptions, valSl []T) error {
-	cDef, ok := definitions.Definitions[cType]
-	if !ok {
-		return fmt.Errorf("config type '%s' not defined", cType)
-	}
-	for _, val := range valSl {
-		if err := vltValue(cDef.Validators, cTypeOpts, validators.Validators, val); err != nil {
-			return err
-		}
+	r, err := DefaultRegistry()
+	if err != nil {
+		return err
 	}
-	return nil
+	return RegistryValidateValueSlice(r, cType, cTypeOpts, valSl)
 }
 
 func CheckValueInOptions[T comparable](v T, opt any) (bool, error) {
@@ -102,7 +97,7 @@ func genVltValParams(cDefVltParams map[string]definitions.ConfigDefinitionValida
 				} else {
 					if cDefVP.Value != nil {
 						vp[name] = cDefVP.Value
-					} else {
+					} else if !cDefVP.Optional {
 						vp = nil
 						break
 					}
@@ -115,8 +110,12 @@ func genVltValParams(cDefVltParams map[string]definitions.ConfigDefinitionValida
 	return vp
 }
 
+// vltValue runs the validators of a definition, validators referencing type options but not the value only check type options and are skipped.
 func vltValue(cDefVlts []definitions.ConfigDefinitionValidator, cTypeOpts model.ConfigTypeOptions, validators map[string]validators.Validator, value any) error {
 	for _, cDefVlt := range cDefVlts {
+		if refsOptionsOnly(cDefVlt.Parameter) {
+			continue
+		}
 		p := genVltValParams(cDefVlt.Parameter, cTypeOpts, value)
 		if len(p) > 0 {
 			vFunc, ok := validators[cDefVlt.Name]
@@ -131,3 +130,16 @@ func vltValue(cDefVlts []definitions.ConfigDefinitionValidator, cTypeOpts model.
 	}
 	return nil
 }
+
+func refsOptionsOnly(cDefVltParams map[string]definitions.ConfigDefinitionValidatorParam) bool {
+	var refsOpt bool
+	for _, cDefVP := range cDefVltParams {
+		if cDefVP.Ref != nil {
+			if *cDefVP.Ref == "value" {
+				return false
+			}
+			refsOpt = true
+		}
+	}
+	return refsOpt
+}
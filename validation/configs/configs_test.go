@@ -20,6 +20,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"testing/fstest"
 
 	"github.com/SENERGY-Platform/mgw-module-lib/model"
 	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs/definitions"
@@ -203,3 +204,263 @@ func TestVltValSlInOpt(t *testing.T) {
 		t.Error("ok == false")
 	}
 }
+
+func TestValidateValue_NumberStep(t *testing.T) {
+	cTypeO := make(model.ConfigTypeOptions)
+	cTypeO.SetInt64("step", 5)
+	if err := ValidateValue("number", cTypeO, int64(10)); err != nil {
+		t.Error("err != nil")
+	}
+	if err := ValidateValue("number", cTypeO, int64(7)); err == nil {
+		t.Error("err == nil")
+	}
+	cTypeO.SetInt64("min", 2)
+	if err := ValidateValue("number", cTypeO, int64(7)); err != nil {
+		t.Error("err != nil")
+	}
+	if err := ValidateValue("number", cTypeO, int64(10)); err == nil {
+		t.Error("err == nil")
+	}
+	// ------------------------------
+	cTypeO = make(model.ConfigTypeOptions)
+	cTypeO.SetFloat64("step", 0.1)
+	if err := ValidateValue("number", cTypeO, 0.3); err != nil {
+		t.Error("err != nil")
+	}
+	if err := ValidateValue("number", cTypeO, 0.35); err == nil {
+		t.Error("err == nil")
+	}
+	cTypeO.SetFloat64("min", 0.05)
+	if err := ValidateValue("number", cTypeO, 0.35); err != nil {
+		t.Error("err != nil")
+	}
+	if err := ValidateValue("number", cTypeO, 1e6+0.05); err != nil {
+		t.Error("err != nil")
+	}
+	if err := ValidateValue("number", cTypeO, 0.3); err == nil {
+		t.Error("err == nil")
+	}
+	// ------------------------------
+	cTypeO = make(model.ConfigTypeOptions)
+	cTypeO.SetInt64("step", 5)
+	cTypeO.SetInt64("min", 0)
+	cTypeO.SetInt64("max", 4)
+	if err := ValidateValue("number", cTypeO, int64(0)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestValidateValue_Network(t *testing.T) {
+	v4 := make(model.ConfigTypeOptions)
+	v4.SetInt64("version", 4)
+	v6 := make(model.ConfigTypeOptions)
+	v6.SetInt64("version", 6)
+	schemes := make(model.ConfigTypeOptions)
+	schemes.SetString("schemes", "mqtt, mqtts")
+	type test struct {
+		cType string
+		opts  model.ConfigTypeOptions
+		value any
+	}
+	ok := []test{
+		{"ip", nil, "192.168.1.1"},
+		{"ip", nil, "::1"},
+		{"ip", v4, "10.0.0.1"},
+		{"ip", v6, "fe80::1"},
+		{"cidr", nil, "10.0.0.0/8"},
+		{"cidr", v6, "fd00::/64"},
+		{"hostname", nil, "broker"},
+		{"hostname", nil, "mqtt.example.com."},
+		{"hostname", nil, "1-test.example"},
+		{"port", nil, int64(1883)},
+		{"port", nil, int64(65535)},
+		{"url", nil, "http://localhost:8080/test"},
+		{"url", schemes, "mqtt://broker:1883"},
+		{"url", schemes, "MQTTS://broker"},
+	}
+	notOk := []test{
+		{"ip", nil, "256.0.0.1"},
+		{"ip", nil, "test"},
+		{"ip", v4, "::1"},
+		{"ip", v6, "10.0.0.1"},
+		{"cidr", nil, "10.0.0.1"},
+		{"cidr", v4, "fd00::/64"},
+		{"hostname", nil, "-test"},
+		{"hostname", nil, "test_a.example"},
+		{"hostname", nil, ""},
+		{"port", nil, int64(0)},
+		{"port", nil, int64(65536)},
+		{"url", nil, "localhost:8080"},
+		{"url", nil, "/test"},
+		{"url", schemes, "http://broker"},
+	}
+	for _, v := range ok {
+		if err := ValidateValue(v.cType, v.opts, v.value); err != nil {
+			t.Errorf("ValidateValue(\"%s\", %v, %v); err != nil", v.cType, v.opts, v.value)
+		}
+	}
+	for _, v := range notOk {
+		if err := ValidateValue(v.cType, v.opts, v.value); err == nil {
+			t.Errorf("ValidateValue(\"%s\", %v, %v); err == nil", v.cType, v.opts, v.value)
+		}
+	}
+	if err := ValidateValueSlice("hostname", nil, []string{"a", "b.c"}); err != nil {
+		t.Error("err != nil")
+	}
+	if err := ValidateValueSlice("port", nil, []int64{80, 0}); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestValidateValue_Units(t *testing.T) {
+	durO := make(model.ConfigTypeOptions)
+	durO.SetString("min", "1s")
+	durO.SetString("max", "1h")
+	sizeO := make(model.ConfigTypeOptions)
+	sizeO.SetString("max", "64MiB")
+	type test struct {
+		cType string
+		opts  model.ConfigTypeOptions
+		value any
+	}
+	ok := []test{
+		{"duration", nil, "30s"},
+		{"duration", nil, "1h30m"},
+		{"duration", durO, "1s"},
+		{"duration", durO, "60m"},
+		{"bytesize", nil, "512"},
+		{"bytesize", nil, "1.5GB"},
+		{"bytesize", sizeO, "64MiB"},
+		{"bytesize", sizeO, "64 mb"},
+		{"bytesize", nil, "1.005KB"},
+		{"bytesize", nil, "0.5KiB"},
+		{"bytesize", nil, "8388607TiB"},
+		{"cron", nil, "*/5 * * * *"},
+		{"cron", nil, "0 8-18/2 1,15 jan-jun MON-FRI"},
+		{"cron", nil, "0 0 * * 7"},
+		{"cron", nil, "@daily"},
+	}
+	notOk := []test{
+		{"duration", nil, "30"},
+		{"duration", nil, "test"},
+		{"duration", durO, "500ms"},
+		{"duration", durO, "2h"},
+		{"duration", nil, "-5s"},
+		{"bytesize", nil, "1.5"},
+		{"bytesize", nil, "64XB"},
+		{"bytesize", nil, "-1"},
+		{"bytesize", nil, "-1KB"},
+		{"bytesize", nil, "1.0005KB"},
+		{"bytesize", nil, "8388608TiB"},
+		{"bytesize", nil, "9223372036854775808"},
+		{"bytesize", sizeO, "65MiB"},
+		{"cron", nil, "* * * *"},
+		{"cron", nil, "60 * * * *"},
+		{"cron", nil, "*/0 * * * *"},
+		{"cron", nil, "0 18-8 * * *"},
+		{"cron", nil, "0 0 * * test"},
+		{"cron", nil, "@test"},
+	}
+	for _, v := range ok {
+		if err := ValidateValue(v.cType, v.opts, v.value); err != nil {
+			t.Errorf("ValidateValue(\"%s\", %v, %v); err != nil", v.cType, v.opts, v.value)
+		}
+	}
+	for _, v := range notOk {
+		if err := ValidateValue(v.cType, v.opts, v.value); err == nil {
+			t.Errorf("ValidateValue(\"%s\", %v, %v); err == nil", v.cType, v.opts, v.value)
+		}
+	}
+}
+
+func TestNormalizeValue(t *testing.T) {
+	a := []struct {
+		cType string
+		value any
+		norm  any
+	}{
+		{"duration", "90s", "1m30s"},
+		{"bytesize", "64KiB", "65536"},
+		{"bytesize", []string{"1kB", "2"}, []string{"1000", "2"}},
+		{"cron", " */5  *  * jan mon ", "*/5 * * JAN MON"},
+		{"cron", "@Daily", "@daily"},
+		{"text", "test", "test"},
+		{"number", int64(1), int64(1)},
+	}
+	for _, v := range a {
+		if b, err := NormalizeValue(v.cType, v.value); err != nil {
+			t.Errorf("NormalizeValue(\"%s\", %v); err != nil", v.cType, v.value)
+		} else if !reflect.DeepEqual(v.norm, b) {
+			t.Errorf("%v != %v", v.norm, b)
+		}
+	}
+	if b, err := NormalizeByteSize("1.005KB"); err != nil || b != "1005" {
+		t.Errorf("%s != 1005", b)
+	}
+	if _, err := NormalizeValue("duration", "-1s"); err == nil {
+		t.Error("err == nil")
+	}
+	if _, err := NormalizeValue("duration", "test"); err == nil {
+		t.Error("err == nil")
+	}
+	if _, err := NormalizeValue("bytesize", int64(1)); err == nil {
+		t.Error("err == nil")
+	}
+	// ------------------------------
+	r, err := NewBuiltInRegistry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{
+		"timeout.json": {Data: []byte(`{"extends": "duration"}`)},
+	}
+	if err = r.LoadDefinitions(fsys); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := r.NormalizeValue("timeout", "90s"); err != nil || b != "1m30s" {
+		t.Errorf("%v != 1m30s", b)
+	}
+	if b, err := r.NormalizeValue("test", "90s"); err != nil || b != "90s" {
+		t.Errorf("%v != 90s", b)
+	}
+}
+
+func TestValidateValue_JSON(t *testing.T) {
+	cTypeO := make(model.ConfigTypeOptions)
+	cTypeO.SetString("schema", `{
+		"type": "array",
+		"items": {
+			"type": "object",
+			"properties": {"topic": {"type": "string", "minLength": 1}, "qos": {"type": "integer", "maximum": 2}},
+			"required": ["topic"]
+		}
+	}`)
+	ok := []string{`[]`, `[{"topic":"a"},{"topic":"b","qos":1}]`}
+	notOk := []string{`{}`, `[{"qos":1}]`, `[{"topic":"a","qos":1.5}]`, `[{"topic":"a","qos":3}]`, `[`, `[] []`}
+	for _, v := range ok {
+		if err := ValidateValue("json", cTypeO, v); err != nil {
+			t.Errorf("ValidateValue(\"json\", cTypeO, %s); err != nil", v)
+		}
+	}
+	for _, v := range notOk {
+		if err := ValidateValue("json", cTypeO, v); err == nil {
+			t.Errorf("ValidateValue(\"json\", cTypeO, %s); err == nil", v)
+		}
+	}
+	a := "validator 'json' returned with: /0: missing properties: 'topic'; /1/qos: must be <= 2 but found 3"
+	if err := ValidateValue("json", cTypeO, `[{"qos":1},{"topic":"a","qos":3}]`); err == nil {
+		t.Error("err == nil")
+	} else if err.Error() != a {
+		t.Errorf("\"%s\" != \"%s\"", err, a)
+	}
+	if err := ValidateValue("json", nil, `{"a":1}`); err != nil {
+		t.Error("err != nil")
+	}
+	if err := ValidateValue("json", nil, `{"a":}`); err == nil {
+		t.Error("err == nil")
+	}
+	cTypeO.SetString("schema", `{"$ref": "http://example.com/schema.json"}`)
+	if err := ValidateValue("json", cTypeO, `{}`); err == nil {
+		t.Error("err == nil")
+	}
+}
@@ -20,45 +20,76 @@ import (
 	"embed"
 	"encoding/json"
 	"fmt"
+	"github.com/SENERGY-Platform/mgw-module-lib/util/sem_ver"
 	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs/validators"
 	"io/fs"
-	"os"
+	"maps"
 	"path"
 	"regexp"
 	"strings"
+	"sync"
 )
 
-var Definitions map[string]ConfigDefinition
-
 //go:embed *.json
 var defFiles embed.FS
 
-func init() {
-	entries, err := defFiles.ReadDir(".")
+// builtIn loads the embedded definitions once on first use.
+var builtIn = sync.OnceValues(loadBuiltIn)
+
+// Definitions returns the built-in definitions, which are shared with the default registry of the configs package.
+// It replaces the former Definitions variable that was populated by an init function, which is a breaking change
+// for importers: read the definitions with definitions.Definitions() and handle the returned error.
+//
+// Deprecated: Use LoadBuiltIn or configs.Registry instead.
+func Definitions() (map[string]ConfigDefinition, error) {
+	return LoadBuiltIn()
+}
+
+// LoadBuiltIn returns a copy of the embedded definitions after checking them against the built-in validators.
+func LoadBuiltIn() (map[string]ConfigDefinition, error) {
+	defs, err := builtIn()
+	if err != nil {
+		return nil, err
+	}
+	return maps.Clone(defs), nil
+}
+
+func loadBuiltIn() (map[string]ConfigDefinition, error) {
+	defs, err := Load(defFiles)
+	if err != nil {
+		return nil, err
+	}
+	if defs, err = Resolve(defs, nil); err != nil {
+		return nil, err
+	}
+	if err = Validate(defs, validators.Validators); err != nil {
+		return nil, err
+	}
+	return defs, nil
+}
+
+// Load decodes all json files in the root directory of fsys, the file names without extension are used as config types.
+func Load(fsys fs.FS) (map[string]ConfigDefinition, error) {
+	entries, err := fs.ReadDir(fsys, ".")
 	if err != nil {
-		fmt.Println("reading definitions failed: ", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("reading definitions failed: %s", err)
 	}
-	Definitions = make(map[string]ConfigDefinition)
+	defs := make(map[string]ConfigDefinition)
 	for _, entry := range entries {
-		if !entry.IsDir() {
-			file, err := defFiles.Open(entry.Name())
-			if err != nil {
-				fmt.Println("opening definition failed: ", err)
-				os.Exit(1)
-			}
-			definition, err := loadDefinition(file)
-			if err != nil {
-				fmt.Println("decoding definition failed: ", err)
-				os.Exit(1)
-			}
-			Definitions[strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))] = definition
+		if entry.IsDir() || path.Ext(entry.Name()) != ".json" {
+			continue
 		}
+		file, err := fsys.Open(entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("opening definition '%s' failed: %s", entry.Name(), err)
+		}
+		definition, err := loadDefinition(file)
+		if err != nil {
+			return nil, fmt.Errorf("decoding definition '%s' failed: %s", entry.Name(), err)
+		}
+		defs[strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))] = definition
 	}
-	if err = validateDefs(Definitions, validators.Validators); err != nil {
-		fmt.Println("validating definitions failed: ", err)
-		os.Exit(1)
-	}
+	return defs, nil
 }
 
 func loadDefinition(file fs.File) (ConfigDefinition, error) {
@@ -70,9 +101,14 @@ func loadDefinition(file fs.File) (ConfigDefinition, error) {
 	return definition, nil
 }
 
-func validateDefs(configDefs map[string]ConfigDefinition, validators map[string]validators.Validator) error {
-	// missing tests and needs to be cleaned up
+// Validate checks the resolved definitions and if all used validators exist.
+func Validate(configDefs map[string]ConfigDefinition, validators map[string]validators.Validator) error {
 	for ref, cDef := range configDefs {
+		if cDef.Version != "" {
+			if _, err := sem_ver.ParseSemVer(cDef.Version); err != nil {
+				return fmt.Errorf("config definition '%s' version '%s' invalid", ref, cDef.Version)
+			}
+		}
 		if len(cDef.DataType) == 0 {
 			return fmt.Errorf("config definition '%s' missing data type", ref)
 		}
@@ -81,20 +117,21 @@ func validateDefs(configDefs map[string]ConfigDefinition, validators map[string]
 				return fmt.Errorf("config definition '%s' option '%s' missing data type", ref, key)
 			}
 		}
-		if len(validators) > 0 {
-			for _, validator := range cDef.Validators {
-				if _, ok := validators[validator.Name]; !ok {
-					return fmt.Errorf("config definition '%s' unknown validator '%s'", ref, validator.Name)
+		for _, validator := range cDef.Validators {
+			if _, ok := validators[validator.Name]; !ok {
+				return fmt.Errorf("config definition '%s' unknown validator '%s'", ref, validator.Name)
+			}
+			for key, param := range validator.Parameter {
+				if param.Ref == nil && param.Value == nil {
+					return fmt.Errorf("config definition '%s' validator '%s' parameter '%s' missing input", ref, validator.Name, key)
 				}
-				for key, param := range validator.Parameter {
-					if param.Ref == nil && param.Value == nil {
-						return fmt.Errorf("config definition '%s' validator '%s' parameter '%s' missing input", ref, validator.Name, key)
-					}
-					if param.Ref != nil {
-						re := regexp.MustCompile(`^options\.[a-z0-9A-Z_]+$|^value$`)
-						if !re.MatchString(*param.Ref) {
-							return fmt.Errorf("config definition '%s' validator '%s' parameter '%s' invalid refrence '%s'", ref, validator.Name, key, *param.Ref)
-						}
+				if param.Optional && (param.Ref == nil || *param.Ref == "value") {
+					return fmt.Errorf("config definition '%s' validator '%s' parameter '%s' optional without option refrence", ref, validator.Name, key)
+				}
+				if param.Ref != nil {
+					re := regexp.MustCompile(`^options\.[a-z0-9A-Z_]+$|^value$`)
+					if !re.MatchString(*param.Ref) {
+						return fmt.Errorf("config definition '%s' validator '%s' parameter '%s' invalid refrence '%s'", ref, validator.Name, key, *param.Ref)
 					}
 				}
 			}
@@ -0,0 +1,155 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package definitions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"github.com/SENERGY-Platform/mgw-module-lib/util/sem_ver"
+	"github.com/SENERGY-Platform/mgw-module-lib/util/tsort"
+)
+
+const extendsSeparator = "@"
+
+// Resolve returns the definitions with the data types, options and validators of their base definitions applied.
+// Base definitions are looked up in configDefs first and then in bases, which must already be resolved.
+// Data types can be narrowed, options added or overridden with compatible data types and validators added or overridden by name.
+// Validators of a definition replace all validators of the base with the same name, other validators of the base are kept.
+func Resolve(configDefs, bases map[string]ConfigDefinition) (map[string]ConfigDefinition, error) {
+	nodes := make(tsort.Nodes)
+	for ref, cDef := range configDefs {
+		var inRef map[string]struct{}
+		if cDef.Extends != "" {
+			name, _, err := parseExtends(cDef.Extends)
+			if err != nil {
+				return nil, fmt.Errorf("config definition '%s' %s", ref, err)
+			}
+			if _, ok := configDefs[name]; ok {
+				inRef = map[string]struct{}{name: {}}
+			} else if _, ok := bases[name]; !ok {
+				return nil, fmt.Errorf("config definition '%s' extends unknown definition '%s'", ref, name)
+			}
+		}
+		nodes.Add(ref, inRef, nil)
+	}
+	order, err := tsort.GetTopOrder(nodes)
+	if err != nil {
+		return nil, fmt.Errorf("config definitions extend cycle: %s", err)
+	}
+	resolved := make(map[string]ConfigDefinition)
+	for _, ref := range order {
+		cDef := configDefs[ref]
+		if cDef.Extends == "" {
+			resolved[ref] = cDef
+			continue
+		}
+		name, vRange, _ := parseExtends(cDef.Extends)
+		base, ok := resolved[name]
+		if !ok {
+			base = bases[name]
+		}
+		if vRange != nil {
+			v, err := sem_ver.ParseSemVer(base.Version)
+			if err != nil {
+				return nil, fmt.Errorf("config definition '%s' base '%s' version '%s' invalid", ref, name, base.Version)
+			}
+			if !vRange.Contains(v) {
+				return nil, fmt.Errorf("config definition '%s' base '%s' version '%s' not in range '%s'", ref, name, base.Version, vRange)
+			}
+		}
+		if resolved[ref], err = extend(base, cDef); err != nil {
+			return nil, fmt.Errorf("config definition '%s' extending '%s': %s", ref, name, err)
+		}
+	}
+	return resolved, nil
+}
+
+func parseExtends(s string) (string, *sem_ver.Range, error) {
+	name, rStr, found := strings.Cut(s, extendsSeparator)
+	if name == "" {
+		return "", nil, fmt.Errorf("extends '%s' missing definition", s)
+	}
+	if !found {
+		return name, nil, nil
+	}
+	r, err := sem_ver.ParseRange(rStr)
+	if err != nil {
+		return "", nil, fmt.Errorf("extends '%s' version range invalid: %s", s, err)
+	}
+	return name, &r, nil
+}
+
+func extend(base, cDef ConfigDefinition) (ConfigDefinition, error) {
+	res := ConfigDefinition{
+		Version:  cDef.Version,
+		Extends:  cDef.Extends,
+		DataType: make(model.Set[model.DataType]),
+	}
+	if len(cDef.DataType) > 0 {
+		for dataType := range cDef.DataType {
+			if _, ok := base.DataType[dataType]; !ok {
+				return ConfigDefinition{}, fmt.Errorf("data type '%s' not supported by base", dataType)
+			}
+			res.DataType[dataType] = struct{}{}
+		}
+	} else {
+		for dataType := range base.DataType {
+			res.DataType[dataType] = struct{}{}
+		}
+	}
+	if len(base.Options)+len(cDef.Options) > 0 {
+		res.Options = make(map[string]ConfigDefinitionOption)
+	}
+	for name, opt := range base.Options {
+		res.Options[name] = opt
+	}
+	for name, opt := range cDef.Options {
+		baseOpt, ok := base.Options[name]
+		if !ok {
+			res.Options[name] = opt
+			continue
+		}
+		if !opt.Inherit && len(opt.DataType) == 0 {
+			opt.Inherit = baseOpt.Inherit
+			opt.DataType = baseOpt.DataType
+		}
+		if opt.Inherit != baseOpt.Inherit {
+			return ConfigDefinition{}, fmt.Errorf("option '%s' inherit can't be overridden", name)
+		}
+		for dataType := range opt.DataType {
+			if _, ok := baseOpt.DataType[dataType]; !ok {
+				return ConfigDefinition{}, fmt.Errorf("option '%s' data type '%s' not supported by base", name, dataType)
+			}
+		}
+		// required options of the base stay required
+		opt.Required = opt.Required || baseOpt.Required
+		res.Options[name] = opt
+	}
+	overridden := make(map[string]struct{})
+	for _, vlt := range cDef.Validators {
+		overridden[vlt.Name] = struct{}{}
+	}
+	for _, vlt := range base.Validators {
+		if _, ok := overridden[vlt.Name]; !ok {
+			res.Validators = append(res.Validators, vlt)
+		}
+	}
+	res.Validators = append(res.Validators, cDef.Validators...)
+	return res, nil
+}
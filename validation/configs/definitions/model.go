@@ -21,6 +21,8 @@ import (
 )
 
 type ConfigDefinition struct {
+	Version    string                            `json:"version"`
+	Extends    string                            `json:"extends"` // {ref:ConfigDefinition}[@{range}]
 	DataType   model.Set[model.DataType]         `json:"data_type"`
 	Options    map[string]ConfigDefinitionOption `json:"options"`
 	Validators []ConfigDefinitionValidator       `json:"validators"`
@@ -38,6 +40,7 @@ type ConfigDefinitionValidator struct {
 }
 
 type ConfigDefinitionValidatorParam struct {
-	Value any     `json:"value"`
-	Ref   *string `json:"ref"`
+	Value    any     `json:"value"`
+	Ref      *string `json:"ref"`
+	Optional bool    `json:"optional"` // omit the parameter instead of skipping the validator if the reference can't be resolved
 }
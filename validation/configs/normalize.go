@@ -0,0 +1,97 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configs
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs/validators"
+)
+
+// NormalizeDuration returns a duration in Go notation, e.g. 90s -> 1m30s.
+func NormalizeDuration(s string) (string, error) {
+	d, err := validators.ParseDuration(s)
+	if err != nil {
+		return "", err
+	}
+	return d.String(), nil
+}
+
+// NormalizeByteSize returns a byte size as number of bytes, e.g. 64KiB -> 65536.
+func NormalizeByteSize(s string) (string, error) {
+	b, err := validators.ParseByteSize(s)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(b, 10), nil
+}
+
+// NormalizeCron returns a cron expression with single spaced fields and upper case names.
+func NormalizeCron(s string) (string, error) {
+	return validators.ParseCron(s)
+}
+
+// normalizers maps the names of validators to the normalization of the values they check.
+var normalizers = map[string]func(string) (string, error){
+	"duration": NormalizeDuration,
+	"bytesize": NormalizeByteSize,
+	"cron":     NormalizeCron,
+}
+
+// NormalizeValue normalizes values of config types of the default registry, see Registry.NormalizeValue.
+func NormalizeValue(cType string, value any) (any, error) {
+	r, err := DefaultRegistry()
+	if err != nil {
+		return nil, err
+	}
+	return r.NormalizeValue(cType, value)
+}
+
+// NormalizeValue normalizes values of config types that use the duration, bytesize or cron validator, e.g. types extending the built-in duration type.
+// Values of other or unknown types are returned unchanged.
+func (r *Registry) NormalizeValue(cType string, value any) (any, error) {
+	def, ok := r.GetDefinition(cType)
+	if !ok {
+		return value, nil
+	}
+	var normalize func(string) (string, error)
+	for _, vlt := range def.Validators {
+		if normalize, ok = normalizers[vlt.Name]; ok {
+			break
+		}
+	}
+	if normalize == nil {
+		return value, nil
+	}
+	switch v := value.(type) {
+	case string:
+		return normalize(v)
+	case []string:
+		sl := make([]string, len(v))
+		for i, s := range v {
+			n, err := normalize(s)
+			if err != nil {
+				return nil, err
+			}
+			sl[i] = n
+		}
+		return sl, nil
+	default:
+		return nil, fmt.Errorf("invalid data type '%T'", value)
+	}
+}
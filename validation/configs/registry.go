@@ -0,0 +1,182 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configs
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"sync"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs/definitions"
+	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs/validators"
+)
+
+// Registry holds config type definitions and validators, it is safe for concurrent use.
+type Registry struct {
+	mu          sync.RWMutex
+	definitions map[string]definitions.ConfigDefinition
+	validators  map[string]validators.Validator
+	readOnly    bool
+}
+
+var ErrReadOnly = errors.New("registry is read-only")
+
+var defaultRegistry = sync.OnceValues(func() (*Registry, error) {
+	r, err := NewBuiltInRegistry()
+	if err != nil {
+		return nil, err
+	}
+	r.readOnly = true
+	return r, nil
+})
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		definitions: make(map[string]definitions.ConfigDefinition),
+		validators:  make(map[string]validators.Validator),
+	}
+}
+
+// NewBuiltInRegistry returns a registry with the built-in definitions and validators.
+func NewBuiltInRegistry() (*Registry, error) {
+	r := NewRegistry()
+	for name, v := range validators.Validators {
+		r.validators[name] = v
+	}
+	defs, err := definitions.LoadBuiltIn()
+	if err != nil {
+		return nil, err
+	}
+	r.definitions = defs
+	return r, nil
+}
+
+// DefaultRegistry returns a shared read-only registry with the built-in definitions and validators, which is used if no registry is provided.
+// Adding definitions or validators fails with ErrReadOnly, use NewBuiltInRegistry to extend the built-in definitions.
+func DefaultRegistry() (*Registry, error) {
+	return defaultRegistry()
+}
+
+func (r *Registry) AddValidator(name string, v validators.Validator) error {
+	if name == "" {
+		return errors.New("empty validator name")
+	}
+	if v == nil {
+		return fmt.Errorf("validator '%s' is nil", name)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.readOnly {
+		return ErrReadOnly
+	}
+	if _, ok := r.validators[name]; ok {
+		return fmt.Errorf("validator '%s' already defined", name)
+	}
+	r.validators[name] = v
+	return nil
+}
+
+func (r *Registry) AddDefinition(name string, def definitions.ConfigDefinition) error {
+	return r.AddDefinitions(map[string]definitions.ConfigDefinition{name: def})
+}
+
+// AddDefinitions adds all or, if a definition is invalid or already defined, none of the definitions.
+// Definitions can extend each other or definitions already added to the registry.
+func (r *Registry) AddDefinitions(defs map[string]definitions.ConfigDefinition) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.readOnly {
+		return ErrReadOnly
+	}
+	for name := range defs {
+		if name == "" {
+			return errors.New("empty config type")
+		}
+		if _, ok := r.definitions[name]; ok {
+			return fmt.Errorf("config type '%s' already defined", name)
+		}
+	}
+	resolved, err := definitions.Resolve(defs, r.definitions)
+	if err != nil {
+		return err
+	}
+	if err = definitions.Validate(resolved, r.validators); err != nil {
+		return err
+	}
+	for name, def := range resolved {
+		r.definitions[name] = def
+	}
+	return nil
+}
+
+// LoadDefinitions adds the definitions of all json files in the root directory of fsys.
+func (r *Registry) LoadDefinitions(fsys fs.FS) error {
+	defs, err := definitions.Load(fsys)
+	if err != nil {
+		return err
+	}
+	return r.AddDefinitions(defs)
+}
+
+func (r *Registry) GetDefinition(cType string) (definitions.ConfigDefinition, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	def, ok := r.definitions[cType]
+	return def, ok
+}
+
+// Lookup returns the definition of a config type and the validators it uses.
+func (r *Registry) Lookup(cType string) (definitions.ConfigDefinition, map[string]validators.Validator, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	def, ok := r.definitions[cType]
+	if !ok {
+		return definitions.ConfigDefinition{}, nil, fmt.Errorf("config type '%s' not defined", cType)
+	}
+	vlts := make(map[string]validators.Validator)
+	for _, cDefVlt := range def.Validators {
+		if v, ok := r.validators[cDefVlt.Name]; ok {
+			vlts[cDefVlt.Name] = v
+		}
+	}
+	return def, vlts, nil
+}
+
+func (r *Registry) ValidateValue(cType string, cTypeOpts model.ConfigTypeOptions, value any) error {
+	cDef, vlts, err := r.Lookup(cType)
+	if err != nil {
+		return err
+	}
+	return vltValue(cDef.Validators, cTypeOpts, vlts, value)
+}
+
+// RegistryValidateValueSlice is the registry counterpart of ValidateValueSlice, Go methods can't have type parameters.
+func RegistryValidateValueSlice[T any](r *Registry, cType string, cTypeOpts model.ConfigTypeOptions, valSl []T) error {
+	cDef, vlts, err := r.Lookup(cType)
+	if err != nil {
+		return err
+	}
+	for _, val := range valSl {
+		if err := vltValue(cDef.Validators, cTypeOpts, vlts, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configs
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs/definitions"
+	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs/validators"
+)
+
+const testDefinition = `{
+  "data_type": ["string"],
+  "options": {"prefix": {"data_type": ["string"]}},
+  "validators": [
+    {
+      "name": "prefix",
+      "parameter": {
+        "string": {"ref": "value"},
+        "prefix": {"ref": "options.prefix"}
+      }
+    }
+  ]
+}`
+
+func testPrefixValidator(params map[string]any) error {
+	s, _ := params["string"].(string)
+	p, _ := params["prefix"].(string)
+	if len(s) < len(p) || s[:len(p)] != p {
+		return errors.New("prefix missing")
+	}
+	return nil
+}
+
+func TestNewRegistry(t *testing.T) {
+	r := NewRegistry()
+	if _, ok := r.GetDefinition("text"); ok {
+		t.Error("ok == true")
+	}
+	if err := r.ValidateValue("text", nil, "test"); err == nil {
+		t.Error("err == nil")
+	}
+	// ------------------------------
+	r, err := NewBuiltInRegistry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := r.GetDefinition("text"); !ok {
+		t.Error("ok == false")
+	}
+	if err = r.ValidateValue("text", nil, "test"); err != nil {
+		t.Error(err)
+	}
+	// ------------------------------
+	a, err := DefaultRegistry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := DefaultRegistry()
+	if a != b {
+		t.Error("a != b")
+	}
+	if err = a.AddDefinition("test", definitions.ConfigDefinition{DataType: model.Set[model.DataType]{model.StringType: {}}}); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("%v != %v", err, ErrReadOnly)
+	}
+	if err = a.AddValidator("test", validators.Validators["regex"]); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("%v != %v", err, ErrReadOnly)
+	}
+	defs, err := definitions.Definitions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := defs["text"]; !ok {
+		t.Error("definition 'text' missing")
+	}
+	delete(defs, "text")
+	if _, ok := a.GetDefinition("text"); !ok {
+		t.Error("definition 'text' removed from default registry")
+	}
+}
+
+func TestRegistry_AddValidator(t *testing.T) {
+	r := NewRegistry()
+	if err := r.AddValidator("prefix", testPrefixValidator); err != nil {
+		t.Error(err)
+	}
+	if err := r.AddValidator("prefix", testPrefixValidator); err == nil {
+		t.Error("err == nil")
+	}
+	if err := r.AddValidator("", testPrefixValidator); err == nil {
+		t.Error("err == nil")
+	}
+	if err := r.AddValidator("test", nil); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestRegistry_AddDefinition(t *testing.T) {
+	str := "value"
+	def := definitions.ConfigDefinition{
+		DataType: map[model.DataType]struct{}{model.StringType: {}},
+		Validators: []definitions.ConfigDefinitionValidator{
+			{
+				Name:      "prefix",
+				Parameter: map[string]definitions.ConfigDefinitionValidatorParam{"string": {Ref: &str}},
+			},
+		},
+	}
+	r := NewRegistry()
+	if err := r.AddDefinition("test", def); err == nil {
+		t.Error("err == nil")
+	}
+	if _, ok := r.GetDefinition("test"); ok {
+		t.Error("ok == true")
+	}
+	if err := r.AddValidator("prefix", testPrefixValidator); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.AddDefinition("test", def); err != nil {
+		t.Error(err)
+	}
+	if err := r.AddDefinition("test", def); err == nil {
+		t.Error("err == nil")
+	}
+	if err := r.AddDefinition("", def); err == nil {
+		t.Error("err == nil")
+	}
+	// ------------------------------
+	err := r.AddDefinitions(map[string]definitions.ConfigDefinition{
+		"a": def,
+		"b": {},
+	})
+	if err == nil {
+		t.Error("err == nil")
+	}
+	if _, ok := r.GetDefinition("a"); ok {
+		t.Error("ok == true")
+	}
+}
+
+func TestRegistry_LoadDefinitions(t *testing.T) {
+	fsys := fstest.MapFS{
+		"test.json":     {Data: []byte(testDefinition)},
+		"README.md":     {Data: []byte("test")},
+		"sub/test.json": {Data: []byte("test")},
+	}
+	r := NewRegistry()
+	if err := r.LoadDefinitions(fsys); err == nil {
+		t.Error("err == nil")
+	}
+	if err := r.AddValidator("prefix", testPrefixValidator); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.LoadDefinitions(fsys); err != nil {
+		t.Fatal(err)
+	}
+	cto := make(model.ConfigTypeOptions)
+	cto.SetString("prefix", "test")
+	if err := r.ValidateValue("test", cto, "test-value"); err != nil {
+		t.Error(err)
+	}
+	if err := r.ValidateValue("test", cto, "value"); err == nil {
+		t.Error("err == nil")
+	}
+	if err := RegistryValidateValueSlice(r, "test", cto, []string{"test-a", "b"}); err == nil {
+		t.Error("err == nil")
+	}
+	if err := ValidateValue("test", cto, "test-value"); err == nil {
+		t.Error("err == nil")
+	}
+	// ------------------------------
+	fsys["test.json"] = &fstest.MapFile{Data: []byte("test")}
+	if err := NewRegistry().LoadDefinitions(fsys); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestRegistry_Concurrent(t *testing.T) {
+	r, err := NewBuiltInRegistry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = r.ValidateValue("text", nil, "test")
+		}()
+	}
+	_ = r.AddValidator("prefix", testPrefixValidator)
+	wg.Wait()
+}
+
+func TestRegistry_Extends(t *testing.T) {
+	r, err := NewBuiltInRegistry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	def, _ := r.GetDefinition("port")
+	if _, ok := def.Options["max"]; !ok {
+		t.Error("option 'max' missing")
+	}
+	if _, ok := def.DataType[model.Float64Type]; ok {
+		t.Error("data type 'float' not removed")
+	}
+	cto := make(model.ConfigTypeOptions)
+	cto.SetInt64("max", 1024)
+	if err = r.ValidateValue("port", cto, int64(80)); err != nil {
+		t.Error(err)
+	}
+	if err = r.ValidateValue("port", cto, int64(8080)); err == nil {
+		t.Error("err == nil")
+	}
+	// ------------------------------
+	fsys := fstest.MapFS{
+		"a.json": {Data: []byte(`{"version": "v1.0.0", "extends": "text", "options": {"min_len": {"required": true}}}`)},
+		"b.json": {Data: []byte(`{"version": "v1.1.0", "extends": "a@>=v1.0.0", "data_type": ["string"]}`)},
+	}
+	if err = r.LoadDefinitions(fsys); err != nil {
+		t.Fatal(err)
+	}
+	def, _ = r.GetDefinition("b")
+	if !def.Options["min_len"].Required {
+		t.Error("option 'min_len' not required")
+	}
+	if _, ok := def.Options["min_len"].DataType[model.Int64Type]; !ok {
+		t.Error("option 'min_len' data type not inherited")
+	}
+	text, _ := r.GetDefinition("text")
+	if len(def.Validators) != len(text.Validators) {
+		t.Errorf("%d != %d", len(def.Validators), len(text.Validators))
+	}
+	// ------------------------------
+	fsys = fstest.MapFS{
+		"f.json": {Data: []byte(`{"extends": "number", "data_type": ["float"], "validators": [{"name": "number_compare", "parameter": {"a": {"ref": "value"}, "b": {"value": 10}, "operator": {"value": "<"}}}]}`)},
+	}
+	if err = r.LoadDefinitions(fsys); err != nil {
+		t.Fatal(err)
+	}
+	def, _ = r.GetDefinition("f")
+	number, _ := r.GetDefinition("number")
+	if len(def.Validators) != len(number.Validators)-2 {
+		t.Errorf("%d != %d", len(def.Validators), len(number.Validators)-2)
+	}
+	cto = make(model.ConfigTypeOptions)
+	cto.SetFloat64("min", 30)
+	if err = r.ValidateValue("f", cto, float64(5)); err != nil {
+		t.Error(err)
+	}
+	if err = r.ValidateValue("f", cto, float64(20)); err == nil {
+		t.Error("err == nil")
+	}
+	// ------------------------------
+	errFiles := []string{
+		`{"extends": "c"}`,
+		`{"extends": "test"}`,
+		`{"extends": "text@^v2.0.0"}`,
+		`{"extends": "text@test"}`,
+		`{"extends": "text", "data_type": ["int"]}`,
+		`{"extends": "text", "options": {"min_len": {"data_type": ["string"]}}}`,
+		`{"extends": "number", "options": {"min": {"data_type": ["int"]}}}`,
+		`{"version": "test", "data_type": ["string"]}`,
+		`{"data_type": ["string"], "validators": [{"name": "regex", "parameter": {"string": {"ref": "value", "optional": true}}}]}`,
+	}
+	for _, s := range errFiles {
+		if err = r.LoadDefinitions(fstest.MapFS{"c.json": {Data: []byte(s)}}); err == nil {
+			t.Errorf("LoadDefinitions(%s); err == nil", s)
+		}
+	}
+	fsys = fstest.MapFS{
+		"c.json": {Data: []byte(`{"extends": "d"}`)},
+		"d.json": {Data: []byte(`{"extends": "e"}`)},
+		"e.json": {Data: []byte(`{"extends": "c"}`)},
+	}
+	if err = r.LoadDefinitions(fsys); err == nil {
+		t.Error("err == nil")
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6, "JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12}},
+	{name: "day of week", min: 0, max: 7, names: map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}},
+}
+
+var cronMacros = map[string]struct{}{
+	"@yearly":   {},
+	"@annually": {},
+	"@monthly":  {},
+	"@weekly":   {},
+	"@daily":    {},
+	"@midnight": {},
+	"@hourly":   {},
+}
+
+// ParseCron checks a standard five field cron expression or macro and returns its normalized form.
+func ParseCron(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "@") {
+		if _, ok := cronMacros[strings.ToLower(s)]; !ok {
+			return "", fmt.Errorf("invalid cron macro '%s'", s)
+		}
+		return strings.ToLower(s), nil
+	}
+	fields := strings.Fields(s)
+	if len(fields) != len(cronFields) {
+		return "", fmt.Errorf("invalid cron expression '%s': %d != %d fields", s, len(fields), len(cronFields))
+	}
+	for i, f := range fields {
+		fields[i] = strings.ToUpper(f)
+		if err := cronFields[i].check(fields[i]); err != nil {
+			return "", fmt.Errorf("invalid cron expression '%s': %s", s, err)
+		}
+	}
+	return strings.Join(fields, " "), nil
+}
+
+func Cron(params map[string]any) error {
+	s, err := getParamValue[string](params, "expression")
+	if err != nil {
+		return err
+	}
+	_, err = ParseCron(s)
+	return err
+}
+
+func (f cronField) check(s string) error {
+	for _, item := range strings.Split(s, ",") {
+		rng, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return fmt.Errorf("%s step '%s' invalid", f.name, step)
+			}
+		}
+		if rng == "*" {
+			continue
+		}
+		a, b, isRange := strings.Cut(rng, "-")
+		start, err := f.value(a)
+		if err != nil {
+			return err
+		}
+		if !isRange {
+			continue
+		}
+		end, err := f.value(b)
+		if err != nil {
+			return err
+		}
+		if start > end {
+			return fmt.Errorf("%s range '%s' invalid", f.name, rng)
+		}
+	}
+	return nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if n, ok := f.names[s]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("%s value '%s' invalid", f.name, s)
+	}
+	return n, nil
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const schemaURL = "mem://config/schema.json"
+
+// schemaCache holds compiled schemas by schema string.
+var schemaCache sync.Map
+
+// JSON checks if the value is a JSON document that, if a schema is given, is valid against the schema.
+func JSON(params map[string]any) error {
+	s, err := getParamValue[string](params, "json")
+	if err != nil {
+		return err
+	}
+	schema, err := getParamValue[string](params, "schema")
+	if err != nil {
+		return err
+	}
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+	var v any
+	if err = d.Decode(&v); err != nil {
+		return fmt.Errorf("invalid JSON: %s", err)
+	}
+	if _, err = d.Token(); err != io.EOF {
+		return errors.New("invalid JSON: trailing data")
+	}
+	if schema == "" {
+		return nil
+	}
+	sch, err := compileSchema(schema)
+	if err != nil {
+		return err
+	}
+	if err = sch.Validate(v); err != nil {
+		var vErr *jsonschema.ValidationError
+		if errors.As(err, &vErr) {
+			return errors.New(fmtValidationError(vErr))
+		}
+		return err
+	}
+	return nil
+}
+
+func JSONSchema(params map[string]any) error {
+	schema, err := getParamValue[string](params, "schema")
+	if err != nil {
+		return err
+	}
+	_, err = compileSchema(schema)
+	return err
+}
+
+// compileSchema compiles a schema without loading external references, compiled schemas are cached.
+func compileSchema(s string) (*jsonschema.Schema, error) {
+	if sch, ok := schemaCache.Load(s); ok {
+		return sch.(*jsonschema.Schema), nil
+	}
+	c := jsonschema.NewCompiler()
+	c.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("loading '%s' not supported", s)
+	}
+	if err := c.AddResource(schemaURL, bytes.NewReader([]byte(s))); err != nil {
+		return nil, fmt.Errorf("invalid schema: %s", err)
+	}
+	sch, err := c.Compile(schemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %s", err)
+	}
+	schemaCache.Store(s, sch)
+	return sch, nil
+}
+
+// fmtValidationError joins the errors of the basic output as '<instance location>: <error>', the root error only referencing the schema is skipped.
+func fmtValidationError(vErr *jsonschema.ValidationError) string {
+	var sl []string
+	for _, e := range vErr.BasicOutput().Errors {
+		if e.KeywordLocation == "" {
+			continue
+		}
+		loc := e.InstanceLocation
+		if loc == "" {
+			loc = "/"
+		}
+		sl = append(sl, loc+": "+e.Error)
+	}
+	return strings.Join(sl, "; ")
+}
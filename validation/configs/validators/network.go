@@ -0,0 +1,161 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	hostnameLabelRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+	urlSchemeRegex     = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*$`)
+)
+
+// IP checks if the value is an IP address, version 4 or 6 restricts the address family and 0 allows both.
+func IP(params map[string]any) error {
+	s, err := getParamValue[string](params, "ip")
+	if err != nil {
+		return err
+	}
+	v, err := getNumberParam[int64](params, "version")
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return fmt.Errorf("invalid IP address '%s'", s)
+	}
+	return checkIPVersion(ip, v)
+}
+
+func IPVersion(params map[string]any) error {
+	v, err := getNumberParam[int64](params, "version")
+	if err != nil {
+		return err
+	}
+	if v != 0 && v != 4 && v != 6 {
+		return fmt.Errorf("invalid IP version %d", v)
+	}
+	return nil
+}
+
+func CIDR(params map[string]any) error {
+	s, err := getParamValue[string](params, "cidr")
+	if err != nil {
+		return err
+	}
+	v, err := getNumberParam[int64](params, "version")
+	if err != nil {
+		return err
+	}
+	ip, _, err := net.ParseCIDR(s)
+	if err != nil {
+		return fmt.Errorf("invalid CIDR '%s'", s)
+	}
+	return checkIPVersion(ip, v)
+}
+
+// Hostname checks if the value is a hostname as defined by RFC 1123.
+func Hostname(params map[string]any) error {
+	s, err := getParamValue[string](params, "hostname")
+	if err != nil {
+		return err
+	}
+	if len(strings.TrimSuffix(s, ".")) > 253 {
+		return errors.New("hostname exceeds 253 characters")
+	}
+	for _, l := range strings.Split(strings.TrimSuffix(s, "."), ".") {
+		if !hostnameLabelRegex.MatchString(l) {
+			return fmt.Errorf("invalid hostname '%s'", s)
+		}
+	}
+	return nil
+}
+
+func Port(params map[string]any) error {
+	p, err := getParamValue[int64](params, "port")
+	if err != nil {
+		return err
+	}
+	if p < 1 || p > 65535 {
+		return fmt.Errorf("port %d not in range 1-65535", p)
+	}
+	return nil
+}
+
+// URL checks if the value is an absolute URL with host, schemes is a comma separated list of allowed schemes and empty allows all.
+func URL(params map[string]any) error {
+	s, err := getParamValue[string](params, "url")
+	if err != nil {
+		return err
+	}
+	schemes, err := getParamValue[string](params, "schemes")
+	if err != nil {
+		return err
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return fmt.Errorf("invalid URL '%s'", s)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid URL '%s': missing scheme or host", s)
+	}
+	if schemes == "" {
+		return nil
+	}
+	for _, scheme := range strings.Split(schemes, ",") {
+		if strings.EqualFold(strings.TrimSpace(scheme), u.Scheme) {
+			return nil
+		}
+	}
+	return fmt.Errorf("scheme '%s' not allowed", u.Scheme)
+}
+
+func URLSchemes(params map[string]any) error {
+	schemes, err := getParamValue[string](params, "schemes")
+	if err != nil {
+		return err
+	}
+	for _, scheme := range strings.Split(schemes, ",") {
+		if !urlSchemeRegex.MatchString(strings.TrimSpace(scheme)) {
+			return fmt.Errorf("invalid scheme '%s'", scheme)
+		}
+	}
+	return nil
+}
+
+func checkIPVersion(ip net.IP, v int64) error {
+	switch v {
+	case 0:
+	case 4:
+		if ip.To4() == nil {
+			return fmt.Errorf("'%s' not an IPv4 address", ip)
+		}
+	case 6:
+		if ip.To4() != nil {
+			return fmt.Errorf("'%s' not an IPv6 address", ip)
+		}
+	default:
+		return fmt.Errorf("invalid IP version %d", v)
+	}
+	return nil
+}
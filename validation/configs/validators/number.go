@@ -17,9 +17,13 @@
 package validators
 
 import (
+	"errors"
 	"fmt"
+	"math"
 )
 
+const floatEpsilon = 1e-9
+
 func NumberCompare(params map[string]any) error {
 	o, err := getParamValue[string](params, "operator")
 	if err != nil {
@@ -59,3 +63,75 @@ func NumberCompare(params map[string]any) error {
 	}
 	return nil
 }
+
+// NumberStep checks if the difference between value and min is a multiple of step.
+func NumberStep(params map[string]any) error {
+	v, err := getParamValue[any](params, "value")
+	if err != nil {
+		return err
+	}
+	switch value := v.(type) {
+	case int64:
+		step, err := getNumberParam[int64](params, "step")
+		if err != nil {
+			return err
+		}
+		min, err := getNumberParam[int64](params, "min")
+		if err != nil {
+			return err
+		}
+		if step <= 0 {
+			return fmt.Errorf("step %d <= 0", step)
+		}
+		if (value-min)%step != 0 {
+			return fmt.Errorf("%d not a multiple of %d starting at %d", value, step, min)
+		}
+	case float64:
+		step, err := getNumberParam[float64](params, "step")
+		if err != nil {
+			return err
+		}
+		min, err := getNumberParam[float64](params, "min")
+		if err != nil {
+			return err
+		}
+		if step <= 0 {
+			return fmt.Errorf("step %f <= 0", step)
+		}
+		q := (value - min) / step
+		if math.Abs(q-math.Round(q)) > floatEpsilon*math.Max(1, math.Abs(q)) {
+			return fmt.Errorf("%f not a multiple of %f starting at %f", value, step, min)
+		}
+	default:
+		return fmt.Errorf("invalid data type: %T != int64 | float64", value)
+	}
+	return nil
+}
+
+// NumberStepRange checks if step is positive and, if min and max are given, does not exceed max-min.
+func NumberStepRange(params map[string]any) error {
+	step, err := getNumberParam[float64](params, "step")
+	if err != nil {
+		return err
+	}
+	if step <= 0 {
+		return errors.New("step <= 0")
+	}
+	_, okMin := params["min"]
+	_, okMax := params["max"]
+	if !okMin || !okMax {
+		return nil
+	}
+	min, err := getNumberParam[float64](params, "min")
+	if err != nil {
+		return err
+	}
+	max, err := getNumberParam[float64](params, "max")
+	if err != nil {
+		return err
+	}
+	if step > max-min {
+		return errors.New("step > max - min")
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var byteSizeRegex = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z]*)$`)
+
+var byteSizeUnits = map[string]int64{
+	"":    1,
+	"b":   1,
+	"kb":  1000,
+	"mb":  1000 * 1000,
+	"gb":  1000 * 1000 * 1000,
+	"tb":  1000 * 1000 * 1000 * 1000,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+}
+
+// ParseByteSize returns the number of bytes of a size like 512, 64MiB or 1.5GB, negative sizes are not allowed.
+func ParseByteSize(s string) (int64, error) {
+	m := byteSizeRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return 0, fmt.Errorf("invalid byte size '%s'", s)
+	}
+	unit, ok := byteSizeUnits[strings.ToLower(m[2])]
+	if !ok {
+		return 0, fmt.Errorf("invalid byte size unit '%s'", m[2])
+	}
+	// the decimal number is parsed exactly as digits / 10^len(fraction) to avoid float rounding
+	intPart, fracPart, _ := strings.Cut(m[1], ".")
+	fracPart = strings.TrimRight(fracPart, "0")
+	num, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return 0, fmt.Errorf("invalid byte size '%s'", s)
+	}
+	num.Mul(num, big.NewInt(unit))
+	den := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(fracPart))), nil)
+	b, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() != 0 {
+		return 0, fmt.Errorf("byte size '%s' not a whole number of bytes", s)
+	}
+	if !b.IsInt64() {
+		return 0, fmt.Errorf("byte size '%s' out of range", s)
+	}
+	return b.Int64(), nil
+}
+
+// ParseDuration parses a duration like 30s or 1h30m, negative durations are not allowed.
+func ParseDuration(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("negative duration '%s'", s)
+	}
+	return d, nil
+}
+
+// Duration checks if the value is a duration like 30s or 1h30m within the optional min and max durations.
+func Duration(params map[string]any) error {
+	return checkUnitRange(params, "duration", ParseDuration, func(d time.Duration) string { return d.String() })
+}
+
+func DurationRange(params map[string]any) error {
+	return checkUnitMinMax(params, ParseDuration)
+}
+
+// ByteSize checks if the value is a byte size like 64MiB within the optional min and max sizes.
+func ByteSize(params map[string]any) error {
+	return checkUnitRange(params, "size", ParseByteSize, func(b int64) string { return strconv.FormatInt(b, 10) + "B" })
+}
+
+func ByteSizeRange(params map[string]any) error {
+	return checkUnitMinMax(params, ParseByteSize)
+}
+
+// checkUnitRange parses the value and, if not empty, the min and max parameters and compares them.
+func checkUnitRange[T time.Duration | int64](params map[string]any, pKey string, parse func(string) (T, error), format func(T) string) error {
+	s, err := getParamValue[string](params, pKey)
+	if err != nil {
+		return err
+	}
+	v, err := parse(s)
+	if err != nil {
+		return err
+	}
+	min, max, err := getUnitMinMax(params, parse)
+	if err != nil {
+		return err
+	}
+	if min != nil && v < *min {
+		return fmt.Errorf("%s < %s", format(v), format(*min))
+	}
+	if max != nil && v > *max {
+		return fmt.Errorf("%s > %s", format(v), format(*max))
+	}
+	return nil
+}
+
+func checkUnitMinMax[T time.Duration | int64](params map[string]any, parse func(string) (T, error)) error {
+	min, max, err := getUnitMinMax(params, parse)
+	if err != nil {
+		return err
+	}
+	if min != nil && max != nil && *min >= *max {
+		return errors.New("min >= max")
+	}
+	return nil
+}
+
+func getUnitMinMax[T time.Duration | int64](params map[string]any, parse func(string) (T, error)) (*T, *T, error) {
+	var bounds [2]*T
+	for i, key := range [2]string{"min", "max"} {
+		s, err := getParamValue[string](params, key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if s == "" {
+			continue
+		}
+		b, err := parse(s)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parameter '%s': %s", key, err)
+		}
+		bounds[i] = &b
+	}
+	return bounds[0], bounds[1], nil
+}
@@ -32,6 +32,25 @@ func getParamValue[T any](params map[string]any, pKey string) (T, error) {
 	return pVal, nil
 }
 
+// getNumberParam returns an int64 or float64 parameter converted to T.
+func getNumberParam[T number](params map[string]any, pKey string) (T, error) {
+	v, ok := params[pKey]
+	if !ok {
+		return 0, fmt.Errorf("parameter '%s' not defined", pKey)
+	}
+	switch n := v.(type) {
+	case int64:
+		return T(n), nil
+	case float64:
+		if t, ok := any(T(n)).(int64); ok && float64(t) != n {
+			return 0, fmt.Errorf("parameter '%s' invalid value: %f != int64", pKey, n)
+		}
+		return T(n), nil
+	default:
+		return 0, fmt.Errorf("parameter '%s' invalid data type: %T != int64 | float64", pKey, v)
+	}
+}
+
 type number interface {
 	int64 | float64
 }
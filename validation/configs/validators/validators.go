@@ -17,9 +17,25 @@
 package validators
 
 var Validators = map[string]Validator{
-	"regex":            Regex,
-	"number_compare":   NumberCompare,
-	"text_len_compare": TextLenCompare,
+	"regex":             Regex,
+	"number_compare":    NumberCompare,
+	"number_step":       NumberStep,
+	"number_step_range": NumberStepRange,
+	"ip":                IP,
+	"ip_version":        IPVersion,
+	"cidr":              CIDR,
+	"hostname":          Hostname,
+	"port":              Port,
+	"url":               URL,
+	"url_schemes":       URLSchemes,
+	"duration":          Duration,
+	"duration_range":    DurationRange,
+	"bytesize":          ByteSize,
+	"bytesize_range":    ByteSizeRange,
+	"cron":              Cron,
+	"json":              JSON,
+	"json_schema":       JSONSchema,
+	"text_len_compare":  TextLenCompare,
 }
 
 type Validator func(params map[string]any) error
@@ -0,0 +1,223 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+var ErrRequired = errors.New("required")
+
+// ValidateConfigValues checks the user values of configs and returns an error per invalid config or unknown value.
+func ValidateConfigValues(cs model.Configs, values map[string]any) (map[string]error, error) {
+	r, err := DefaultRegistry()
+	if err != nil {
+		return nil, err
+	}
+	return r.ValidateConfigValues(cs, values), nil
+}
+
+// ValidateConfigValues checks the user values of configs and returns an error per invalid config or unknown value.
+// A config without a value or with an empty string is only invalid if it is required and has no default value.
+// Values are converted with ConvertValue, so numbers decoded from JSON or plain ints are accepted.
+func (r *Registry) ValidateConfigValues(cs model.Configs, values map[string]any) map[string]error {
+	errs := make(map[string]error)
+	for ref := range values {
+		if _, ok := cs[ref]; !ok {
+			errs[ref] = fmt.Errorf("config '%s' not defined", ref)
+		}
+	}
+	for ref, cv := range cs {
+		val, ok := values[ref]
+		if s, k := val.(string); k && s == "" {
+			ok = false
+		}
+		if !ok || val == nil {
+			if cv.Required && cv.Default == nil {
+				errs[ref] = ErrRequired
+			}
+			continue
+		}
+		val, err := ConvertValue(cv, val)
+		if err != nil {
+			errs[ref] = err
+			continue
+		}
+		if err = r.ValidateConfigValue(cv, val); err != nil {
+			errs[ref] = err
+		}
+	}
+	return errs
+}
+
+// ValidateConfigValue checks if a value has the data type of the config, is one of the options unless OptExt is set and passes the validators of the config type.
+// The value must have the exact Go type of the config, e.g. int64 or []int64 for int configs, use ConvertValue for user values.
+func (r *Registry) ValidateConfigValue(cv model.ConfigValue, value any) error {
+	switch cv.DataType {
+	case model.StringType:
+		return validateConfigValue[string](r, cv, value)
+	case model.BoolType:
+		return validateConfigValue[bool](r, cv, value)
+	case model.Int64Type:
+		return validateConfigValue[int64](r, cv, value)
+	case model.Float64Type:
+		return validateConfigValue[float64](r, cv, value)
+	default:
+		return fmt.Errorf("data type '%s' not supported", cv.DataType)
+	}
+}
+
+func validateConfigValue[T comparable](r *Registry, cv model.ConfigValue, value any) error {
+	if cv.IsSlice {
+		valSl, ok := value.([]T)
+		if !ok {
+			return fmt.Errorf("invalid data type '%T'", value)
+		}
+		if len(valSl) == 0 {
+			if cv.Required && cv.Default == nil {
+				return ErrRequired
+			}
+			return nil
+		}
+		if !cv.OptExt && cv.OptionsLen() > 0 {
+			if ok, err := CheckValueSliceInOptions(valSl, cv.Options); err != nil {
+				return fmt.Errorf("checking options failed: %s", err)
+			} else if !ok {
+				return fmt.Errorf("value '%v' not in options", valSl)
+			}
+		}
+		if cv.Type != "" {
+			return RegistryValidateValueSlice(r, cv.Type, cv.TypeOpt, valSl)
+		}
+		return nil
+	}
+	val, ok := value.(T)
+	if !ok {
+		return fmt.Errorf("invalid data type '%T'", value)
+	}
+	if !cv.OptExt && cv.OptionsLen() > 0 {
+		if ok, err := CheckValueInOptions(val, cv.Options); err != nil {
+			return fmt.Errorf("checking options failed: %s", err)
+		} else if !ok {
+			return fmt.Errorf("value '%v' not in options", val)
+		}
+	}
+	if cv.Type != "" {
+		return r.ValidateValue(cv.Type, cv.TypeOpt, val)
+	}
+	return nil
+}
+
+// ConvertValue converts a value to the Go type of the config, e.g. int or integral float64 to int64 for int configs and []any to []string for string slice configs.
+func ConvertValue(cv model.ConfigValue, value any) (any, error) {
+	switch cv.DataType {
+	case model.StringType:
+		return convertValue(cv.IsSlice, value, toString)
+	case model.BoolType:
+		return convertValue(cv.IsSlice, value, toBool)
+	case model.Int64Type:
+		return convertValue(cv.IsSlice, value, toInt64)
+	case model.Float64Type:
+		return convertValue(cv.IsSlice, value, toFloat64)
+	default:
+		return nil, fmt.Errorf("data type '%s' not supported", cv.DataType)
+	}
+}
+
+func convertValue[T any](isSlice bool, value any, conv func(any) (T, bool)) (any, error) {
+	if !isSlice {
+		v, ok := conv(value)
+		if !ok {
+			return nil, fmt.Errorf("invalid data type '%T'", value)
+		}
+		return v, nil
+	}
+	if valSl, ok := value.([]T); ok {
+		return valSl, nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("invalid data type '%T'", value)
+	}
+	valSl := make([]T, rv.Len())
+	for i := range valSl {
+		e := rv.Index(i).Interface()
+		v, ok := conv(e)
+		if !ok {
+			return nil, fmt.Errorf("invalid data type '%T' at index %d", e, i)
+		}
+		valSl[i] = v
+	}
+	return valSl, nil
+}
+
+func toString(v any) (string, bool) {
+	s, ok := v.(string)
+	return s, ok
+}
+
+func toBool(v any) (bool, bool) {
+	b, ok := v.(bool)
+	return b, ok
+}
+
+func toInt64(v any) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case int:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case float64:
+		if n != math.Trunc(n) || n < math.MinInt64 || n >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(n), true
+	case json.Number:
+		i, err := n.Int64()
+		return i, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func toFloat64(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
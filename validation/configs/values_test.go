@@ -0,0 +1,187 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configs
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+func TestValidateConfigValues(t *testing.T) {
+	def := "a"
+	cto := make(model.ConfigTypeOptions)
+	cto.SetInt64("max", 10)
+	cs := make(model.Configs)
+	cs.SetString("str", &def, []string{"a", "b"}, false, "", nil, true)
+	cs.SetString("ext", nil, []string{"a", "b"}, true, "", nil, false)
+	cs.SetInt64("num", nil, nil, false, "number", cto, true)
+	cs.SetInt64Slice("nums", nil, []int64{1, 2, 3}, false, "number", cto, ",", false)
+	cs.SetStringSlice("list", nil, nil, false, "", nil, ",", true)
+	cs.SetBool("flag", nil, nil, false, "", nil, false)
+	values := map[string]any{
+		"ext":  "c",
+		"num":  int64(5),
+		"nums": []int64{1, 3},
+		"list": []string{"test"},
+	}
+	errs, err := ValidateConfigValues(cs, values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 0 {
+		t.Errorf("len(%v) != 0", errs)
+	}
+	// ------------------------------
+	values = map[string]any{
+		"str":     "c",
+		"num":     int64(11),
+		"nums":    []int64{1, 4},
+		"list":    []string{},
+		"flag":    "true",
+		"unknown": "test",
+	}
+	errs, err = ValidateConfigValues(cs, values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ref := range []string{"str", "num", "nums", "list", "flag", "unknown"} {
+		if errs[ref] == nil {
+			t.Errorf("errs[\"%s\"] == nil", ref)
+		}
+	}
+	if len(errs) != 6 {
+		t.Errorf("len(%v) != 6", errs)
+	}
+	if !errors.Is(errs["list"], ErrRequired) {
+		t.Errorf("%v != %v", errs["list"], ErrRequired)
+	}
+	// ------------------------------
+	values = map[string]any{
+		"str":  "",
+		"num":  float64(5),
+		"nums": []any{float64(1), 3},
+		"list": []any{"test"},
+	}
+	errs, err = ValidateConfigValues(cs, values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 0 {
+		t.Errorf("len(%v) != 0", errs)
+	}
+	// ------------------------------
+	errs, err = ValidateConfigValues(cs, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ref := range []string{"num", "list"} {
+		if !errors.Is(errs[ref], ErrRequired) {
+			t.Errorf("%v != %v", errs[ref], ErrRequired)
+		}
+	}
+	if len(errs) != 2 {
+		t.Errorf("len(%v) != 2", errs)
+	}
+	// ------------------------------
+	cs.SetString("name", nil, nil, false, "", nil, true)
+	values = map[string]any{
+		"name": "",
+		"num":  int64(5),
+		"list": []string{"test"},
+	}
+	errs, err = ValidateConfigValues(cs, values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !errors.Is(errs["name"], ErrRequired) {
+		t.Errorf("%v != %v", errs["name"], ErrRequired)
+	}
+	if len(errs) != 1 {
+		t.Errorf("len(%v) != 1", errs)
+	}
+}
+
+func TestRegistry_ValidateConfigValue(t *testing.T) {
+	r := NewRegistry()
+	cs := make(model.Configs)
+	cs.SetInt64("a", nil, nil, false, "number", nil, false)
+	cs.SetInt64("b", nil, nil, false, "", nil, false)
+	if err := r.ValidateConfigValue(cs["a"], int64(1)); err == nil {
+		t.Error("err == nil")
+	}
+	if err := r.ValidateConfigValue(cs["b"], int64(1)); err != nil {
+		t.Error(err)
+	}
+	if err := r.ValidateConfigValue(cs["b"], 1); err == nil {
+		t.Error("err == nil")
+	}
+	if err := r.ValidateConfigValue(cs["b"], float64(1)); err == nil {
+		t.Error("err == nil")
+	}
+	if err := r.ValidateConfigValue(model.ConfigValue{DataType: "test"}, 1); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestConvertValue(t *testing.T) {
+	cs := make(model.Configs)
+	cs.SetInt64("int", nil, nil, false, "", nil, false)
+	cs.SetFloat64("float", nil, nil, false, "", nil, false)
+	cs.SetStringSlice("strs", nil, nil, false, "", nil, ",", false)
+	cs.SetInt64Slice("ints", nil, nil, false, "", nil, ",", false)
+	a := []struct {
+		ref   string
+		value any
+		conv  any
+	}{
+		{"int", 1, int64(1)},
+		{"int", float64(2), int64(2)},
+		{"int", json.Number("3"), int64(3)},
+		{"float", 1, float64(1)},
+		{"float", json.Number("1.5"), 1.5},
+		{"strs", []any{"a", "b"}, []string{"a", "b"}},
+		{"ints", []float64{1, 2}, []int64{1, 2}},
+	}
+	for _, v := range a {
+		if b, err := ConvertValue(cs[v.ref], v.value); err != nil {
+			t.Errorf("ConvertValue(%s, %v); err != nil", v.ref, v.value)
+		} else if !reflect.DeepEqual(v.conv, b) {
+			t.Errorf("%v != %v", v.conv, b)
+		}
+	}
+	b := []struct {
+		ref   string
+		value any
+	}{
+		{"int", 1.5},
+		{"int", float64(1 << 63)},
+		{"int", "1"},
+		{"float", "1"},
+		{"strs", "a"},
+		{"strs", []any{"a", 1}},
+		{"ints", []any{1, nil}},
+	}
+	for _, v := range b {
+		if _, err := ConvertValue(cs[v.ref], v.value); err == nil {
+			t.Errorf("ConvertValue(%s, %v); err == nil", v.ref, v.value)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs"
 	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs/definitions"
 	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs/validators"
 )
@@ -110,6 +111,19 @@ func TestGenVltOptParams(t *testing.T) {
 	if b = genVltOptParams(cDefVP, cTypeO); len(b) != 0 {
 		t.Errorf("len(%v) != 0", b)
 	}
+	// ------------------------------
+	cDefVP[""] = definitions.ConfigDefinitionValidatorParam{
+		Ref:      &oRef,
+		Optional: true,
+	}
+	cDefVP["b"] = definitions.ConfigDefinitionValidatorParam{
+		Ref:      &oRef2,
+		Optional: true,
+	}
+	b = genVltOptParams(cDefVP, cTypeO)
+	if reflect.DeepEqual(a, b) == false {
+		t.Errorf("%v != %v", a, b)
+	}
 }
 
 func TestVltOptions(t *testing.T) {
@@ -290,3 +304,103 @@ func TestVltBase(t *testing.T) {
 		t.Error("err == nil")
 	}
 }
+
+func TestValidateTypeOptions_NumberStep(t *testing.T) {
+	cTypeO := make(model.ConfigTypeOptions)
+	cTypeO.SetInt64("step", 5)
+	if err := validateTypeOptions(testRegistry(t), "number", cTypeO); err != nil {
+		t.Error("err != nil")
+	}
+	cTypeO.SetInt64("min", 0)
+	cTypeO.SetInt64("max", 5)
+	if err := validateTypeOptions(testRegistry(t), "number", cTypeO); err != nil {
+		t.Error("err != nil")
+	}
+	cTypeO.SetInt64("max", 4)
+	if err := validateTypeOptions(testRegistry(t), "number", cTypeO); err == nil {
+		t.Error("err == nil")
+	}
+	cTypeO = make(model.ConfigTypeOptions)
+	cTypeO.SetInt64("step", 0)
+	cTypeO.SetInt64("max", 10)
+	if err := validateTypeOptions(testRegistry(t), "number", cTypeO); err == nil {
+		t.Error("err == nil")
+	}
+	cTypeO = make(model.ConfigTypeOptions)
+	cTypeO.SetInt64("step", 0)
+	if err := validateTypeOptions(testRegistry(t), "number", cTypeO); err == nil {
+		t.Error("err == nil")
+	}
+	cTypeO = make(model.ConfigTypeOptions)
+	cTypeO.SetFloat64("step", -0.5)
+	if err := validateTypeOptions(testRegistry(t), "number", cTypeO); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestValidateTypeOptions_Network(t *testing.T) {
+	cTypeO := make(model.ConfigTypeOptions)
+	cTypeO.SetInt64("version", 4)
+	if err := validateTypeOptions(testRegistry(t), "ip", cTypeO); err != nil {
+		t.Error("err != nil")
+	}
+	cTypeO.SetInt64("version", 5)
+	if err := validateTypeOptions(testRegistry(t), "cidr", cTypeO); err == nil {
+		t.Error("err == nil")
+	}
+	cTypeO = make(model.ConfigTypeOptions)
+	cTypeO.SetString("schemes", "http,https")
+	if err := validateTypeOptions(testRegistry(t), "url", cTypeO); err != nil {
+		t.Error("err != nil")
+	}
+	cTypeO.SetString("schemes", "http,")
+	if err := validateTypeOptions(testRegistry(t), "url", cTypeO); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestValidateTypeOptions_Units(t *testing.T) {
+	cTypeO := make(model.ConfigTypeOptions)
+	cTypeO.SetString("min", "1s")
+	cTypeO.SetString("max", "1m")
+	if err := validateTypeOptions(testRegistry(t), "duration", cTypeO); err != nil {
+		t.Error("err != nil")
+	}
+	cTypeO.SetString("max", "1s")
+	if err := validateTypeOptions(testRegistry(t), "duration", cTypeO); err == nil {
+		t.Error("err == nil")
+	}
+	cTypeO = make(model.ConfigTypeOptions)
+	cTypeO.SetString("min", "test")
+	if err := validateTypeOptions(testRegistry(t), "bytesize", cTypeO); err == nil {
+		t.Error("err == nil")
+	}
+	cTypeO.SetString("min", "1MiB")
+	if err := validateTypeOptions(testRegistry(t), "bytesize", cTypeO); err != nil {
+		t.Error("err != nil")
+	}
+}
+
+func TestValidateTypeOptions_JSON(t *testing.T) {
+	cTypeO := make(model.ConfigTypeOptions)
+	cTypeO.SetString("schema", `{"$schema": "https://json-schema.org/draft/2020-12/schema", "type": "object"}`)
+	if err := validateTypeOptions(testRegistry(t), "json", cTypeO); err != nil {
+		t.Error("err != nil")
+	}
+	cTypeO.SetString("schema", `{"type": "test"}`)
+	if err := validateTypeOptions(testRegistry(t), "json", cTypeO); err == nil {
+		t.Error("err == nil")
+	}
+	cTypeO.SetString("schema", `{`)
+	if err := validateTypeOptions(testRegistry(t), "json", cTypeO); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func testRegistry(t *testing.T) *configs.Registry {
+	r, err := configs.DefaultRegistry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
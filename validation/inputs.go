@@ -17,115 +17,131 @@
 package validation
 
 import (
-	"errors"
-	"fmt"
-
 	"github.com/SENERGY-Platform/mgw-module-lib/model"
 )
 
 func validateInputsResources(inputs map[string]model.Input, mResources map[string]model.HostResource) error {
+	var errs issues
 	if len(inputs) > 0 && len(mResources) == 0 {
-		return errors.New("no resources defined")
+		errs.addf("", CodeUndefinedReference, "no resources defined")
+		return errs.err()
 	}
 	for ref := range inputs {
 		if ref == "" {
-			return errors.New("invalid input reference")
+			errs.addf("", CodeEmptyReference, "invalid input reference")
+			continue
 		}
 		if _, ok := mResources[ref]; !ok {
-			return fmt.Errorf("resource '%s' not defined", ref)
+			errs.addf(ref, CodeUndefinedReference, "resource '%s' not defined", ref)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateInputsSecrets(inputs map[string]model.Input, mSecrets map[string]model.Secret) error {
+	var errs issues
 	if len(inputs) > 0 && len(mSecrets) == 0 {
-		return errors.New("no secrets defined")
+		errs.addf("", CodeUndefinedReference, "no secrets defined")
+		return errs.err()
 	}
 	for ref := range inputs {
 		if ref == "" {
-			return errors.New("invalid input reference")
+			errs.addf("", CodeEmptyReference, "invalid input reference")
+			continue
 		}
 		if _, ok := mSecrets[ref]; !ok {
-			return fmt.Errorf("secret '%s' not defined", ref)
+			errs.addf(ref, CodeUndefinedReference, "secret '%s' not defined", ref)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateInputsConfigs(inputs map[string]model.Input, mConfigs model.Configs) error {
+	var errs issues
 	if len(inputs) > 0 && len(mConfigs) == 0 {
-		return errors.New("no configs defined")
+		errs.addf("", CodeUndefinedReference, "no configs defined")
+		return errs.err()
 	}
 	for ref := range inputs {
 		if ref == "" {
-			return errors.New("invalid input reference")
+			errs.addf("", CodeEmptyReference, "invalid input reference")
+			continue
 		}
 		if _, ok := mConfigs[ref]; !ok {
-			return fmt.Errorf("config '%s' not defined", ref)
+			errs.addf(ref, CodeUndefinedReference, "config '%s' not defined", ref)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateInputsFiles(inputs map[string]model.Input, mFiles map[string]model.File) error {
+	var errs issues
 	if len(inputs) > 0 && len(mFiles) == 0 {
-		return errors.New("no files defined")
+		errs.addf("", CodeUndefinedReference, "no files defined")
+		return errs.err()
 	}
 	for ref := range inputs {
 		if ref == "" {
-			return errors.New("invalid input reference")
+			errs.addf("", CodeEmptyReference, "invalid input reference")
+			continue
 		}
 		if _, ok := mFiles[ref]; !ok {
-			return fmt.Errorf("file '%s' not defined", ref)
+			errs.addf(ref, CodeUndefinedReference, "file '%s' not defined", ref)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateInputsFileGroups(inputs map[string]model.Input, mFileGroups map[string]struct{}) error {
+	var errs issues
 	if len(inputs) > 0 && len(mFileGroups) == 0 {
-		return errors.New("no file groups defined")
+		errs.addf("", CodeUndefinedReference, "no file groups defined")
+		return errs.err()
 	}
 	for ref := range inputs {
 		if ref == "" {
-			return errors.New("invalid input reference")
+			errs.addf("", CodeEmptyReference, "invalid input reference")
+			continue
 		}
 		if _, ok := mFileGroups[ref]; !ok {
-			return fmt.Errorf("file group '%s' not defined", ref)
+			errs.addf(ref, CodeUndefinedReference, "file group '%s' not defined", ref)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateInputsAndGroups(inputs map[string]model.Input, groups map[string]model.InputGroup) error {
-	for _, input := range inputs {
+	var errs issues
+	for ref, input := range inputs {
 		if input.Group != "" {
 			if len(groups) == 0 {
-				return errors.New("no input groups defined")
+				errs.addf(joinPath(ref, "group"), CodeUndefinedReference, "no input groups defined")
+				continue
 			}
 			if _, ok := groups[input.Group]; !ok {
-				return fmt.Errorf("input group '%s' not defined", input.Group)
+				errs.addf(joinPath(ref, "group"), CodeUndefinedReference, "input group '%s' not defined", input.Group)
 			}
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateInputGroups(groups map[string]model.InputGroup) error {
+	var errs issues
 	for ref, group := range groups {
 		if ref == "" {
-			return errors.New("invalid input group reference")
+			errs.addf("", CodeEmptyReference, "invalid input group reference")
+			continue
 		}
 		if group.Group != "" {
 			if g, ok := groups[group.Group]; !ok {
-				return fmt.Errorf("input group '%s' not defined", group.Group)
+				errs.addf(joinPath(ref, "group"), CodeUndefinedReference, "input group '%s' not defined", group.Group)
 			} else {
 				if g.Group != "" && g.Group == group.Group {
-					return fmt.Errorf("input group '%s' reference cycle", group.Group)
+					errs.addf(joinPath(ref, "group"), CodeReferenceCycle, "input group '%s' reference cycle", group.Group)
 				}
 			}
 		}
 	}
-	return nil
+	return errs.err()
 }
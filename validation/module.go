@@ -17,100 +17,72 @@
 package validation
 
 import (
-	"errors"
-	"fmt"
-	"regexp"
-
 	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"github.com/SENERGY-Platform/mgw-module-lib/util/img_ref"
 	"github.com/SENERGY-Platform/mgw-module-lib/util/sem_ver"
+	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs"
 )
 
-func Validate(m model.Module) error {
+// Validate returns the first error of the checks in order, its message is prefixed with the path of the issue.
+func Validate(m model.Module, opts ...Option) error {
+	return ValidateWithReport(m, opts...).Err()
+}
+
+func ValidateWithReport(m model.Module, opts ...Option) ValidationReport {
+	o := getOptions(opts)
+	var errs issues
+	reg, err := o.getRegistry()
+	if err != nil {
+		errs.addf("configs", CodeInvalidConfigType, "%s", err)
+		return newReport(errs)
+	}
 	if !isValidModuleID(m.ID) {
-		return fmt.Errorf("invalid module ID format '%s'", m.ID)
+		errs.addf("id", CodeInvalidFormat, "invalid module ID format '%s'", m.ID)
 	}
-	if !sem_ver.IsValidSemVer(m.Version) {
-		return fmt.Errorf("invalid version format '%s'", m.Version)
+	if _, err := sem_ver.ParseSemVer(m.Version); err != nil {
+		errs.addf("version", CodeInvalidFormat, "invalid version format '%s'", m.Version)
 	}
+	errs.merge("architectures", validateArchitectures(m.Architectures))
 	if !validateKeyNotEmptyString(m.Volumes) {
-		return errors.New("empty volume name")
-	}
-	if err := validateModuleDependencies(m.Dependencies); err != nil {
-		return fmt.Errorf("invalid dependency configuration: %s", err)
-	}
-	if err := validateResources(m.HostResources, m.Inputs.Resources); err != nil {
-		return fmt.Errorf("invalid resource configuration: %s", err)
-	}
-	if err := validateSecrets(m.Secrets, m.Inputs.Secrets); err != nil {
-		return fmt.Errorf("invalid secret configuration: %s", err)
-	}
-	if err := validateConfigs(m.Configs, m.Inputs.Configs); err != nil {
-		return fmt.Errorf("invalid config configuration: %s", err)
-	}
-	if err := validateConfigTypeOptions(m.Configs, m.Inputs.Configs); err != nil {
-		return fmt.Errorf("invalid config type options configuration: %s", err)
-	}
-	if err := validateFiles(m.Files, m.Inputs.Files); err != nil {
-		return fmt.Errorf("invalid file configuration: %s", err)
-	}
-	if err := validateFileGroups(m.FileGroups, m.Inputs.FileGroups); err != nil {
-		return fmt.Errorf("invalid file group configuration: %s", err)
-	}
-	if err := validateInputGroups(m.Inputs.Groups); err != nil {
-		return fmt.Errorf("invalid input group configuration: %s", err)
-	}
-	if err := validateInputsAndGroups(m.Inputs.Resources, m.Inputs.Groups); err != nil {
-		return fmt.Errorf("invalid input group configuration: %s", err)
-	}
-	if err := validateInputsAndGroups(m.Inputs.Secrets, m.Inputs.Groups); err != nil {
-		return fmt.Errorf("invalid input group configuration: %s", err)
-	}
-	if err := validateInputsAndGroups(m.Inputs.Configs, m.Inputs.Groups); err != nil {
-		return fmt.Errorf("invalid input group configuration: %s", err)
-	}
-	if err := validateInputsAndGroups(m.Inputs.Files, m.Inputs.Groups); err != nil {
-		return fmt.Errorf("invalid input group configuration: %s", err)
-	}
-	if err := validateInputsAndGroups(m.Inputs.FileGroups, m.Inputs.Groups); err != nil {
-		return fmt.Errorf("invalid input group configuration: %s", err)
-	}
-	if err := validateInputsResources(m.Inputs.Resources, m.HostResources); err != nil {
-		return fmt.Errorf("invalid input configuration: %s", err)
-	}
-	if err := validateInputsSecrets(m.Inputs.Secrets, m.Secrets); err != nil {
-		return fmt.Errorf("invalid input configuration: %s", err)
-	}
-	if err := validateInputsConfigs(m.Inputs.Configs, m.Configs); err != nil {
-		return fmt.Errorf("invalid input configuration: %s", err)
-	}
-	if err := validateInputsFiles(m.Inputs.Files, m.Files); err != nil {
-		return fmt.Errorf("invalid input configuration: %s", err)
-	}
-	if err := validateInputsFileGroups(m.Inputs.FileGroups, m.FileGroups); err != nil {
-		return fmt.Errorf("invalid input configuration: %s", err)
-	}
-	if err := validateServices(m.Services, m.Volumes, m.HostResources, m.Secrets, m.Configs, m.Dependencies, m.Files, m.FileGroups); err != nil {
-		return err
-	}
-	if err := validateAuxServices(m.AuxServices, m.Volumes, m.Configs, m.Dependencies, m.Services); err != nil {
-		return err
-	}
-	if err := validateAuxImgSrc(m.AuxImgSrc); err != nil {
-		return err
-	}
-	return nil
+		errs.addf("volumes", CodeEmptyReference, "empty volume name")
+	}
+	errs.merge("dependencies", validateModuleDependencies(m.Dependencies))
+	errs.merge("host_resources", validateResources(m.HostResources, m.Inputs.Resources))
+	errs.merge("secrets", validateSecrets(m.Secrets, m.Inputs.Secrets))
+	errs.merge("configs", validateConfigs(m.Configs, m.Inputs.Configs))
+	errs.merge("configs", validateConfigTypeOptions(reg, m.Configs, m.Inputs.Configs))
+	errs.merge("files", validateFiles(m.Files, m.Inputs.Files))
+	errs.merge("file_groups", validateFileGroups(m.FileGroups, m.Inputs.FileGroups))
+	errs.merge("inputs.groups", validateInputGroups(m.Inputs.Groups))
+	errs.merge("inputs.resources", validateInputsAndGroups(m.Inputs.Resources, m.Inputs.Groups))
+	errs.merge("inputs.secrets", validateInputsAndGroups(m.Inputs.Secrets, m.Inputs.Groups))
+	errs.merge("inputs.configs", validateInputsAndGroups(m.Inputs.Configs, m.Inputs.Groups))
+	errs.merge("inputs.files", validateInputsAndGroups(m.Inputs.Files, m.Inputs.Groups))
+	errs.merge("inputs.file_groups", validateInputsAndGroups(m.Inputs.FileGroups, m.Inputs.Groups))
+	errs.merge("inputs.resources", validateInputsResources(m.Inputs.Resources, m.HostResources))
+	errs.merge("inputs.secrets", validateInputsSecrets(m.Inputs.Secrets, m.Secrets))
+	errs.merge("inputs.configs", validateInputsConfigs(m.Inputs.Configs, m.Configs))
+	errs.merge("inputs.files", validateInputsFiles(m.Inputs.Files, m.Files))
+	errs.merge("inputs.file_groups", validateInputsFileGroups(m.Inputs.FileGroups, m.FileGroups))
+	errs.merge("services", validateServices(m.Services, m.Volumes, m.HostResources, m.Secrets, m.Configs, m.Dependencies, m.Files, m.FileGroups))
+	errs.merge("services", validateServiceOrder(m.Services))
+	errs.merge("services", validateServiceImages(m.Services, m.Architectures, o.strictImages))
+	errs.merge("aux_services", validateAuxServices(m.AuxServices, m.Volumes, m.Configs, m.Dependencies, m.Services))
+	errs.merge("aux_img_src", validateAuxImgSrc(m.AuxImgSrc))
+	return newReport(errs)
 }
 
 func validateModuleDependencies(dependencies map[string]string) error {
+	var errs issues
 	for mid, ver := range dependencies {
 		if !isValidModuleID(mid) {
-			return fmt.Errorf("invalid module ID format '%s'", mid)
+			errs.addf(mid, CodeInvalidFormat, "invalid module ID format '%s'", mid)
 		}
-		if err := sem_ver.ValidateSemVerRange(ver); err != nil {
-			return fmt.Errorf("version %s", err)
+		if _, err := sem_ver.ParseRange(ver); err != nil {
+			errs.addf(mid, CodeInvalidFormat, "version %s", err)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func isValidModuleID(s string) bool {
@@ -118,96 +90,143 @@ func isValidModuleID(s string) bool {
 }
 
 func isValidCPUArch(s string) bool {
-	_, ok := model.CPUArchMap[s]
-	return ok
+	_, err := model.NormalizeCPUArch(s)
+	return err == nil
+}
+
+func validateArchitectures(mArchs map[string]struct{}) error {
+	var errs issues
+	seen := make(map[model.CPUArch]string)
+	for _, arch := range sortedKeys(mArchs) {
+		a, err := model.NormalizeCPUArch(arch)
+		if err != nil {
+			errs.addf("", CodeInvalidValue, "unknown architecture '%s'", arch)
+			continue
+		}
+		if prev, ok := seen[a]; ok {
+			errs.addf("", CodeDuplicate, "architecture '%s' duplicates '%s'", arch, prev)
+			continue
+		}
+		seen[a] = arch
+	}
+	return errs.err()
 }
 
 func validateResources(mRs map[string]model.HostResource, inputs map[string]model.Input) error {
+	var errs issues
 	for ref, r := range mRs {
 		if ref == "" {
-			return errors.New("empty resource reference")
+			errs.addf("", CodeEmptyReference, "empty resource reference")
+			continue
 		}
 		if r.Required && len(r.Tags) == 0 {
 			if _, ok := inputs[ref]; !ok {
-				return fmt.Errorf("resource '%s' is required but no tags or input defined", ref)
+				errs.addf(ref, CodeMissingInput, "resource '%s' is required but no tags or input defined", ref)
 			}
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateSecrets(mSs map[string]model.Secret, inputs map[string]model.Input) error {
+	var errs issues
 	for ref, s := range mSs {
 		if ref == "" {
-			return errors.New("empty secret reference")
+			errs.addf("", CodeEmptyReference, "empty secret reference")
+			continue
 		}
 		if s.Required && len(s.Tags) == 0 {
 			if _, ok := inputs[ref]; !ok {
-				return fmt.Errorf("secret '%s' is required but no tags or input defined", ref)
+				errs.addf(ref, CodeMissingInput, "secret '%s' is required but no tags or input defined", ref)
 			}
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateConfigs(mCs model.Configs, inputs map[string]model.Input) error {
+	var errs issues
 	for ref, cv := range mCs {
 		if ref == "" {
-			return errors.New("empty config reference")
+			errs.addf("", CodeEmptyReference, "empty config reference")
+			continue
 		}
 		if cv.Required && cv.Default == nil {
 			if _, ok := inputs[ref]; !ok {
-				return fmt.Errorf("config '%s' is required but no default value or input defined", ref)
+				errs.addf(ref, CodeMissingInput, "config '%s' is required but no default value or input defined", ref)
 			}
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateFiles(mFs map[string]model.File, inputs map[string]model.Input) error {
+	var errs issues
 	for ref := range mFs {
 		if ref == "" {
-			return errors.New("empty file reference")
+			errs.addf("", CodeEmptyReference, "empty file reference")
+			continue
 		}
 		if _, ok := inputs[ref]; !ok {
-			return fmt.Errorf("file '%s' no input defined", ref)
+			errs.addf(ref, CodeMissingInput, "file '%s' no input defined", ref)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateFileGroups(mFs map[string]struct{}, inputs map[string]model.Input) error {
+	var errs issues
 	for ref := range mFs {
 		if ref == "" {
-			return errors.New("empty file group reference")
+			errs.addf("", CodeEmptyReference, "empty file group reference")
+			continue
 		}
 		if _, ok := inputs[ref]; !ok {
-			return fmt.Errorf("file group '%s' no input defined", ref)
+			errs.addf(ref, CodeMissingInput, "file group '%s' no input defined", ref)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateAuxImgSrc(sources map[string]struct{}) error {
-	re := regexp.MustCompile(`^[a-zA-Z0-9\/_\.-]+(?:$|\*$)`)
+	var errs issues
 	for src := range sources {
-		if !re.MatchString(src) {
-			return fmt.Errorf("invalid aux service image source '%s'", src)
+		if err := img_ref.ValidatePattern(src); err != nil {
+			errs.addf(src, CodeInvalidFormat, "invalid aux service image source: %s", err)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
-func validateConfigTypeOptions(mCs model.Configs, inputs map[string]model.Input) error {
+func validateConfigTypeOptions(reg *configs.Registry, mCs model.Configs, inputs map[string]model.Input) error {
+	var errs issues
 	for ref, cv := range mCs {
-		if _, ok := inputs[ref]; ok {
-			if err := validateTypeOptionsBase(cv.Type, cv.TypeOpt, cv.DataType); err != nil {
-				return err
+		if _, ok := inputs[ref]; ok || cv.Type != "" {
+			if err := validateTypeOptionsBase(reg, cv.Type, cv.TypeOpt, cv.DataType); err != nil {
+				errs.addf(joinPath(ref, "type_opt"), CodeInvalidConfigType, "%s", err)
+				continue
 			}
-			if err := validateTypeOptions(cv.Type, cv.TypeOpt); err != nil {
-				return err
+			if err := validateTypeOptions(reg, cv.Type, cv.TypeOpt); err != nil {
+				errs.addf(joinPath(ref, "type_opt"), CodeInvalidConfigType, "%s", err)
+				continue
+			}
+		}
+		// defaults and options must have the exact Go type of the data type, they are not converted like user values
+		if cv.Default != nil {
+			if err := reg.ValidateConfigValue(cv, cv.Default); err != nil {
+				errs.addf(joinPath(ref, "default"), CodeInvalidValue, "invalid default value: %s", err)
+			}
+		}
+		if cv.Options != nil {
+			// options are checked like a slice value without checking the options themselves
+			optCv := cv
+			optCv.IsSlice = true
+			optCv.OptExt = true
+			optCv.Required = false
+			if err := reg.ValidateConfigValue(optCv, cv.Options); err != nil {
+				errs.addf(joinPath(ref, "options"), CodeInvalidValue, "invalid options: %s", err)
 			}
 		}
 	}
-	return nil
+	return errs.err()
 }
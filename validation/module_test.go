@@ -46,6 +46,24 @@ func TestValidate(t *testing.T) {
 		t.Error("err == nil")
 	}
 	// ------------------------------
+	m = model.Module{
+		ID:            "test.test/test",
+		Version:       "v1.0.0",
+		Architectures: map[string]struct{}{model.AMD64: {}, model.AARCH64: {}},
+	}
+	if err := Validate(m); err != nil {
+		t.Error("err != nil")
+	}
+	// ------------------------------
+	m = model.Module{
+		ID:            "test.test/test",
+		Version:       "v1.0.0",
+		Architectures: map[string]struct{}{"test": {}},
+	}
+	if err := Validate(m); err == nil {
+		t.Error("err == nil")
+	}
+	// ------------------------------
 	m = model.Module{
 		ID:      "test.test/test",
 		Version: "v1.0.0",
@@ -194,6 +212,23 @@ func TestIsValidCPUArch(t *testing.T) {
 	if isValidCPUArch("test") != false {
 		t.Error("isValidCPUArch(\"test\") != false")
 	}
+	if isValidCPUArch("arm64") != true {
+		t.Error("isValidCPUArch(\"arm64\") != true")
+	}
+}
+
+func TestValidateArchitectures(t *testing.T) {
+	if err := validateArchitectures(map[string]struct{}{model.AMD64: {}, "arm64": {}}); err != nil {
+		t.Error(err)
+	}
+	if err := validateArchitectures(map[string]struct{}{model.AMD64: {}, model.X86_64: {}}); err == nil {
+		t.Error("err == nil")
+	} else if err.Error() != "architecture 'x86_64' duplicates 'amd64'" {
+		t.Errorf("\"%s\" != \"architecture 'x86_64' duplicates 'amd64'\"", err)
+	}
+	if err := validateArchitectures(map[string]struct{}{"test": {}}); err == nil {
+		t.Error("err == nil")
+	}
 }
 
 func TestValidateConfigs(t *testing.T) {
@@ -237,6 +272,47 @@ func TestValidateConfigs(t *testing.T) {
 	}
 }
 
+func TestValidateConfigTypeOptions(t *testing.T) {
+	reg := testRegistry(t)
+	def := int64(8080)
+	cto := make(model.ConfigTypeOptions)
+	cto.SetInt64("min", 1024)
+	mCs := make(model.Configs)
+	mCs.SetInt64("a", &def, []int64{8080, 9090}, false, "number", cto, false)
+	mCs.SetStringSlice("b", []string{"x"}, []string{"x", "y"}, false, "", nil, ",", false)
+	mCs.SetString("c", nil, nil, false, "", nil, false)
+	if err := validateConfigTypeOptions(reg, mCs, nil); err != nil {
+		t.Error(err)
+	}
+	// ------------------------------
+	errCs := make(model.Configs)
+	errCs.SetInt64("default_option", &def, []int64{9090}, false, "", nil, false)
+	low := int64(80)
+	errCs.SetInt64("default_type_opt", &low, nil, false, "number", cto, false)
+	errCs.SetInt64("options_type_opt", nil, []int64{80, 8080}, true, "number", cto, false)
+	errCs.SetStringSlice("default_slice", []string{"z"}, []string{"x", "y"}, false, "", nil, ",", false)
+	errCs["data_type"] = model.ConfigValue{DataType: model.Int64Type, Default: "test"}
+	errCs["options_data_type"] = model.ConfigValue{DataType: model.Int64Type, Options: []string{"test"}}
+	errCs["default_float"] = model.ConfigValue{DataType: model.Int64Type, Default: float64(5)}
+	errCs["default_int"] = model.ConfigValue{DataType: model.Int64Type, Default: 5}
+	errCs["options_any"] = model.ConfigValue{DataType: model.Int64Type, Options: []any{5, 6}}
+	errCs.SetInt64("type", nil, nil, false, "test", nil, false)
+	for ref, cv := range errCs {
+		if err := validateConfigTypeOptions(reg, model.Configs{ref: cv}, nil); err == nil {
+			t.Errorf("%s: err == nil", ref)
+		}
+	}
+	// ------------------------------
+	m := model.Module{
+		ID:      "test.test/test",
+		Version: "v1.0.0",
+		Configs: errCs,
+	}
+	if err := Validate(m); err == nil {
+		t.Error("err == nil")
+	}
+}
+
 func TestValidateResources(t *testing.T) {
 	var mRs map[string]model.HostResource
 	var inputs map[string]model.Input
@@ -358,3 +434,15 @@ func TestValidateSecrets(t *testing.T) {
 		t.Error("err != nil")
 	}
 }
+
+func TestValidateAuxImgSrc(t *testing.T) {
+	if err := validateAuxImgSrc(map[string]struct{}{"nginx": {}, "ghcr.io/senergy-platform/*": {}}); err != nil {
+		t.Error("err != nil")
+	}
+	if err := validateAuxImgSrc(map[string]struct{}{"nginx:latest": {}}); err == nil {
+		t.Error("err == nil")
+	}
+	if err := validateAuxImgSrc(map[string]struct{}{"ghcr.io/*/test": {}}); err == nil {
+		t.Error("err == nil")
+	}
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validation
+
+import "github.com/SENERGY-Platform/mgw-module-lib/validation/configs"
+
+type Option func(*options)
+
+type options struct {
+	strictImages bool
+	registry     *configs.Registry
+}
+
+// WithStrictImages requires service images to be pinned by digest or a tag other than latest.
+func WithStrictImages() Option {
+	return func(o *options) {
+		o.strictImages = true
+	}
+}
+
+// WithRegistry sets the registry used to validate config types, the default registry is used otherwise.
+func WithRegistry(r *configs.Registry) Option {
+	return func(o *options) {
+		o.registry = r
+	}
+}
+
+func getOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+func (o options) getRegistry() (*configs.Registry, error) {
+	if o.registry != nil {
+		return o.registry, nil
+	}
+	return configs.DefaultRegistry()
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type Severity = string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+const (
+	CodeInvalidFormat      = "invalid_format"
+	CodeInvalidValue       = "invalid_value"
+	CodeEmptyReference     = "empty_reference"
+	CodeUndefinedReference = "undefined_reference"
+	CodeDuplicate          = "duplicate"
+	CodeMissingInput       = "missing_input"
+	CodeReferenceCycle     = "reference_cycle"
+	CodeInvalidConfigType  = "invalid_config_type"
+	CodeUnpinnedImage      = "unpinned_image"
+)
+
+type Issue struct {
+	Path     string   `json:"path"` // e.g. services.web.volumes./data
+	Code     string   `json:"code"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+func (i Issue) Error() string {
+	if i.Path == "" {
+		return i.Message
+	}
+	return fmt.Sprintf("%s: %s", i.Path, i.Message)
+}
+
+type ValidationReport struct {
+	Issues []Issue `json:"issues"`
+}
+
+func (r ValidationReport) Valid() bool {
+	return len(r.Errors()) == 0
+}
+
+func (r ValidationReport) Errors() []Issue {
+	return r.filter(SeverityError)
+}
+
+func (r ValidationReport) Warnings() []Issue {
+	return r.filter(SeverityWarning)
+}
+
+// Err returns the first issue with error severity or nil.
+// Issues are in the order of the checks, the message of an issue is prefixed with its path, e.g. "version: invalid version format 'test'".
+func (r ValidationReport) Err() error {
+	for _, i := range r.Issues {
+		if i.Severity == SeverityError {
+			return i
+		}
+	}
+	return nil
+}
+
+func (r ValidationReport) filter(s Severity) []Issue {
+	var sl []Issue
+	for _, i := range r.Issues {
+		if i.Severity == s {
+			sl = append(sl, i)
+		}
+	}
+	return sl
+}
+
+func newReport(is issues) ValidationReport {
+	return ValidationReport{Issues: is}
+}
+
+// issues collects validation issues and is returned as error by the validate functions.
+type issues []Issue
+
+func (is issues) Error() string {
+	var sl []string
+	for _, i := range is {
+		sl = append(sl, i.Error())
+	}
+	return strings.Join(sl, "; ")
+}
+
+func (is *issues) addf(path, code, format string, a ...any) {
+	*is = append(*is, Issue{
+		Path:     path,
+		Code:     code,
+		Severity: SeverityError,
+		Message:  fmt.Sprintf(format, a...),
+	})
+}
+
+func (is *issues) warnf(path, code, format string, a ...any) {
+	*is = append(*is, Issue{
+		Path:     path,
+		Code:     code,
+		Severity: SeverityWarning,
+		Message:  fmt.Sprintf(format, a...),
+	})
+}
+
+// merge adds the issues of err sorted by path and with their paths prefixed, other errors are added as single issue.
+func (is *issues) merge(prefix string, err error) {
+	if err == nil {
+		return
+	}
+	var sub issues
+	if errors.As(err, &sub) {
+		sub = append(issues(nil), sub...)
+		sort.SliceStable(sub, func(i, j int) bool {
+			return sub[i].Path < sub[j].Path
+		})
+		for _, i := range sub {
+			i.Path = joinPath(prefix, i.Path)
+			*is = append(*is, i)
+		}
+		return
+	}
+	is.addf(prefix, CodeInvalidValue, "%s", err)
+}
+
+func (is issues) err() error {
+	if len(is) == 0 {
+		return nil
+	}
+	return is
+}
+
+func joinPath(a, b string) string {
+	if a == "" {
+		return b
+	}
+	if b == "" {
+		return a
+	}
+	return a + "." + b
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"github.com/SENERGY-Platform/mgw-module-lib/validation/configs"
+)
+
+func TestValidateWithReport(t *testing.T) {
+	m := model.Module{
+		ID:      "test.test/test",
+		Version: "v1.0.0",
+	}
+	r := ValidateWithReport(m)
+	if !r.Valid() {
+		t.Error("r.Valid() == false")
+	}
+	if len(r.Issues) != 0 {
+		t.Errorf("len(%v) != 0", r.Issues)
+	}
+	if r.Err() != nil {
+		t.Error("r.Err() != nil")
+	}
+	// ------------------------------
+	m = model.Module{
+		ID:      "test.test/test",
+		Version: "test",
+		Volumes: map[string]struct{}{"data": {}},
+		Services: map[string]model.Service{
+			"web": {
+				Image:   "nginx:1.25",
+				Volumes: map[string]string{"/data": "test", "/data2": "data"},
+				Configs: map[string]string{"VAR": "test"},
+			},
+		},
+	}
+	r = ValidateWithReport(m)
+	if r.Valid() {
+		t.Error("r.Valid() == true")
+	}
+	a := []Issue{
+		{
+			Path:     "version",
+			Code:     CodeInvalidFormat,
+			Severity: SeverityError,
+			Message:  "invalid version format 'test'",
+		},
+		{
+			Path:     "services.web.configs",
+			Code:     CodeUndefinedReference,
+			Severity: SeverityError,
+			Message:  "no configs defined",
+		},
+		{
+			Path:     "services.web.volumes./data",
+			Code:     CodeUndefinedReference,
+			Severity: SeverityError,
+			Message:  "volume 'test' not defined",
+		},
+	}
+	if !reflect.DeepEqual(a, r.Issues) {
+		t.Errorf("%v != %v", a, r.Issues)
+	}
+	if err := Validate(m); err == nil {
+		t.Error("err == nil")
+	} else if err.Error() != "version: invalid version format 'test'" {
+		t.Errorf("\"%s\" != \"version: invalid version format 'test'\"", err)
+	}
+}
+
+func TestValidateWithReport_StrictImages(t *testing.T) {
+	m := model.Module{
+		ID:       "test.test/test",
+		Version:  "v1.0.0",
+		Services: map[string]model.Service{"web": {Image: "nginx"}},
+	}
+	r := ValidateWithReport(m)
+	if !r.Valid() {
+		t.Error("r.Valid() == false")
+	}
+	a := []Issue{
+		{
+			Path:     "services.web.image",
+			Code:     CodeUnpinnedImage,
+			Severity: SeverityWarning,
+			Message:  "image 'nginx' not pinned by digest or tag",
+		},
+	}
+	if !reflect.DeepEqual(a, r.Warnings()) {
+		t.Errorf("%v != %v", a, r.Warnings())
+	}
+	if err := Validate(m, WithStrictImages()); err == nil {
+		t.Error("err == nil")
+	}
+	m.Services["web"] = model.Service{Image: "nginx:1.25"}
+	if err := Validate(m, WithStrictImages()); err != nil {
+		t.Error("err != nil")
+	}
+}
+
+func TestValidateWithReport_Registry(t *testing.T) {
+	cs := make(model.Configs)
+	cs.SetString("test", nil, nil, false, "text", nil, false)
+	m := model.Module{
+		ID:      "test.test/test",
+		Version: "v1.0.0",
+		Configs: cs,
+		Inputs: model.Inputs{
+			Configs: map[string]model.Input{"test": {}},
+		},
+	}
+	if err := Validate(m); err != nil {
+		t.Error("err != nil")
+	}
+	if err := Validate(m, WithRegistry(configs.NewRegistry())); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestIssues_Merge(t *testing.T) {
+	var errs issues
+	errs.merge("a", nil)
+	if len(errs) != 0 {
+		t.Errorf("len(%v) != 0", errs)
+	}
+	var sub issues
+	sub.addf("c", CodeDuplicate, "test")
+	sub.addf("", CodeEmptyReference, "test")
+	errs.merge("a.b", sub.err())
+	errs.merge("a", errors.New("test"))
+	a := issues{
+		{Path: "a.b", Code: CodeEmptyReference, Severity: SeverityError, Message: "test"},
+		{Path: "a.b.c", Code: CodeDuplicate, Severity: SeverityError, Message: "test"},
+		{Path: "a", Code: CodeInvalidValue, Severity: SeverityError, Message: "test"},
+	}
+	if !reflect.DeepEqual(a, errs) {
+		t.Errorf("%v != %v", a, errs)
+	}
+	if errs.Error() != "a.b: test; a.b.c: test; a: test" {
+		t.Errorf("\"%s\" != \"a.b: test; a.b.c: test; a: test\"", errs.Error())
+	}
+}
+
+func TestIssues_Err(t *testing.T) {
+	var errs issues
+	if err := errs.err(); err != nil {
+		t.Error("err != nil")
+	}
+	errs.addf("", "", "")
+	if err := errs.err(); err == nil {
+		t.Error("err == nil")
+	}
+}
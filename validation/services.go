@@ -19,8 +19,14 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/SENERGY-Platform/mgw-module-lib/model"
+	"github.com/SENERGY-Platform/mgw-module-lib/util/img_ref"
+	"github.com/SENERGY-Platform/mgw-module-lib/util/srv_order"
+	"github.com/SENERGY-Platform/mgw-module-lib/util/tsort"
 )
 
 func validateServices(
@@ -33,247 +39,302 @@ func validateServices(
 	mFiles map[string]model.File,
 	mFileGroups map[string]struct{},
 ) error {
+	var errs issues
 	extPaths := make(map[string]struct{})
 	hostPorts := make(map[string]struct{})
 	for ref, service := range mServices {
 		refVars := make(map[string]struct{})
 		mntPts := make(map[string]struct{})
 		if ref == "" {
-			return errors.New("empty service reference")
-		}
-		if err := validateMapKeys(service.BindMounts, mntPts); err != nil {
-			return fmt.Errorf("service '%s' invalid include mount point configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.Tmpfs, mntPts); err != nil {
-			return fmt.Errorf("service '%s' invalid tmpfs mount point configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.Volumes, mntPts); err != nil {
-			return fmt.Errorf("service '%s' invalid volume mount point configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.HostResources, mntPts); err != nil {
-			return fmt.Errorf("service '%s' invalid resource mount point configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.SecretMounts, mntPts); err != nil {
-			return fmt.Errorf("service '%s' invalid secret mount point configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.Files, mntPts); err != nil {
-			return fmt.Errorf("service '%s' invalid file mount point configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.FileGroups, mntPts); err != nil {
-			return fmt.Errorf("service '%s' invalid file group mount point configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.SecretVars, refVars); err != nil {
-			return fmt.Errorf("service '%s' invalid secret reference variable configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.Configs, refVars); err != nil {
-			return fmt.Errorf("service '%s' invalid config reference variable configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.SrvReferences, refVars); err != nil {
-			return fmt.Errorf("service '%s' invalid service reference variable configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.ExtDependencies, refVars); err != nil {
-			return fmt.Errorf("service '%s' invalid external dependency reference variable configuration: %s", ref, err)
-		}
-		if err := validateServiceVolumes(service.Volumes, mVolumes); err != nil {
-			return fmt.Errorf("service '%s' invalid volume configuration: %s", ref, err)
-		}
-		if err := validateServiceResources(service.HostResources, mResources); err != nil {
-			return fmt.Errorf("service '%s' invalid resource configuration: %s", ref, err)
-		}
-		if err := validateServiceSecrets(service.SecretMounts, service.SecretVars, mSecrets); err != nil {
-			return fmt.Errorf("service '%s' invalid secret configuration: %s", ref, err)
-		}
-		if err := validateServiceFiles(service.Files, mFiles); err != nil {
-			return fmt.Errorf("service '%s' invalid file configuration: %s", ref, err)
-		}
-		if err := validateServiceFileGroups(service.FileGroups, mFileGroups); err != nil {
-			return fmt.Errorf("service '%s' invalid file configuration: %s", ref, err)
-		}
-		if err := validateServiceConfigs(service.Configs, mConfigs); err != nil {
-			return fmt.Errorf("service '%s' invalid config configuration: %s", ref, err)
-		}
-		if err := validateServiceHttpEndpoints(service.HttpEndpoints, extPaths); err != nil {
-			return fmt.Errorf("service '%s' invalid http endpoint configuration: %s", ref, err)
-		}
-		if err := validateServiceReferences(service.SrvReferences, mServices); err != nil {
-			return fmt.Errorf("service '%s' invalid reference configuration: %s", ref, err)
-		}
-		if err := validateServiceExternalDependencies(service.ExtDependencies, mDependencies); err != nil {
-			return fmt.Errorf("service '%s' invalid external dependency configuration: %s", ref, err)
-		}
-		if err := validateServicePorts(service.Ports, hostPorts); err != nil {
-			return fmt.Errorf("service '%s' invalid port mapping configuration: %s", ref, err)
+			errs.addf("", CodeEmptyReference, "empty service reference")
+			continue
 		}
+		if service.Image != "" {
+			if _, err := img_ref.Parse(service.Image); err != nil {
+				errs.addf(joinPath(ref, "image"), CodeInvalidFormat, "%s", err)
+			}
+		} else if len(service.ArchImages) == 0 {
+			errs.addf(joinPath(ref, "image"), CodeEmptyReference, "missing image")
+		}
+		errs.merge(joinPath(ref, "bind_mounts"), validateMapKeys(service.BindMounts, mntPts))
+		errs.merge(joinPath(ref, "tmpfs"), validateMapKeys(service.Tmpfs, mntPts))
+		errs.merge(joinPath(ref, "volumes"), validateMapKeys(service.Volumes, mntPts))
+		errs.merge(joinPath(ref, "host_resources"), validateMapKeys(service.HostResources, mntPts))
+		errs.merge(joinPath(ref, "secret_mounts"), validateMapKeys(service.SecretMounts, mntPts))
+		errs.merge(joinPath(ref, "files"), validateMapKeys(service.Files, mntPts))
+		errs.merge(joinPath(ref, "file_groups"), validateMapKeys(service.FileGroups, mntPts))
+		errs.merge(joinPath(ref, "secret_vars"), validateMapKeys(service.SecretVars, refVars))
+		errs.merge(joinPath(ref, "configs"), validateMapKeys(service.Configs, refVars))
+		errs.merge(joinPath(ref, "srv_references"), validateMapKeys(service.SrvReferences, refVars))
+		errs.merge(joinPath(ref, "ext_dependencies"), validateMapKeys(service.ExtDependencies, refVars))
+		errs.merge(joinPath(ref, "volumes"), validateServiceVolumes(service.Volumes, mVolumes))
+		errs.merge(joinPath(ref, "host_resources"), validateServiceResources(service.HostResources, mResources))
+		errs.merge(ref, validateServiceSecrets(service.SecretMounts, service.SecretVars, mSecrets))
+		errs.merge(joinPath(ref, "files"), validateServiceFiles(service.Files, mFiles))
+		errs.merge(joinPath(ref, "file_groups"), validateServiceFileGroups(service.FileGroups, mFileGroups))
+		errs.merge(joinPath(ref, "configs"), validateServiceConfigs(service.Configs, mConfigs))
+		errs.merge(joinPath(ref, "http_endpoints"), validateServiceHttpEndpoints(service.HttpEndpoints, extPaths))
+		errs.merge(joinPath(ref, "srv_references"), validateServiceReferences(service.SrvReferences, mServices))
+		errs.merge(joinPath(ref, "ext_dependencies"), validateServiceExternalDependencies(service.ExtDependencies, mDependencies))
+		errs.merge(joinPath(ref, "ports"), validateServicePorts(service.Ports, hostPorts))
 	}
-	return nil
+	return errs.err()
 }
 
 func validateAuxServices(auxServices map[string]model.AuxService, mVolumes map[string]struct{}, mConfigs model.Configs, mDependencies map[string]string, mServices map[string]model.Service) error {
+	var errs issues
 	for ref, service := range auxServices {
 		refVars := make(map[string]struct{})
 		mntPts := make(map[string]struct{})
 		if ref == "" {
-			return errors.New("empty service reference")
-		}
-		if err := validateMapKeys(service.BindMounts, mntPts); err != nil {
-			return fmt.Errorf("aux service '%s' invalid include mount point configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.Tmpfs, mntPts); err != nil {
-			return fmt.Errorf("aux service '%s' invalid tmpfs mount point configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.Volumes, mntPts); err != nil {
-			return fmt.Errorf("aux service '%s' invalid volume mount point configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.Configs, refVars); err != nil {
-			return fmt.Errorf("aux service '%s' invalid config reference variable configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.SrvReferences, refVars); err != nil {
-			return fmt.Errorf("aux service '%s' invalid service reference variable configuration: %s", ref, err)
-		}
-		if err := validateMapKeys(service.ExtDependencies, refVars); err != nil {
-			return fmt.Errorf("aux service '%s' invalid external dependency reference variable configuration: %s", ref, err)
-		}
-		if err := validateServiceVolumes(service.Volumes, mVolumes); err != nil {
-			return fmt.Errorf("aux service '%s' invalid volume configuration: %s", ref, err)
-		}
-		if err := validateServiceConfigs(service.Configs, mConfigs); err != nil {
-			return fmt.Errorf("aux service '%s' invalid config configuration: %s", ref, err)
-		}
-		if err := validateServiceReferences(service.SrvReferences, mServices); err != nil {
-			return fmt.Errorf("aux service '%s' invalid reference configuration: %s", ref, err)
-		}
-		if err := validateServiceExternalDependencies(service.ExtDependencies, mDependencies); err != nil {
-			return fmt.Errorf("aux service '%s' invalid external dependency configuration: %s", ref, err)
-		}
+			errs.addf("", CodeEmptyReference, "empty service reference")
+			continue
+		}
+		errs.merge(joinPath(ref, "bind_mounts"), validateMapKeys(service.BindMounts, mntPts))
+		errs.merge(joinPath(ref, "tmpfs"), validateMapKeys(service.Tmpfs, mntPts))
+		errs.merge(joinPath(ref, "volumes"), validateMapKeys(service.Volumes, mntPts))
+		errs.merge(joinPath(ref, "configs"), validateMapKeys(service.Configs, refVars))
+		errs.merge(joinPath(ref, "srv_references"), validateMapKeys(service.SrvReferences, refVars))
+		errs.merge(joinPath(ref, "ext_dependencies"), validateMapKeys(service.ExtDependencies, refVars))
+		errs.merge(joinPath(ref, "volumes"), validateServiceVolumes(service.Volumes, mVolumes))
+		errs.merge(joinPath(ref, "configs"), validateServiceConfigs(service.Configs, mConfigs))
+		errs.merge(joinPath(ref, "srv_references"), validateServiceReferences(service.SrvReferences, mServices))
+		errs.merge(joinPath(ref, "ext_dependencies"), validateServiceExternalDependencies(service.ExtDependencies, mDependencies))
 	}
-	return nil
+	return errs.err()
 }
 
 func validateServiceVolumes(sVolumes map[string]string, mVolumes map[string]struct{}) error {
+	var errs issues
 	if len(sVolumes) > 0 && len(mVolumes) == 0 {
-		return errors.New("no volumes defined")
+		errs.addf("", CodeUndefinedReference, "no volumes defined")
+		return errs.err()
 	}
-	for _, volume := range sVolumes {
+	for mntPt, volume := range sVolumes {
 		if _, ok := mVolumes[volume]; !ok {
-			return fmt.Errorf("volume '%s' not defined", volume)
+			errs.addf(mntPt, CodeUndefinedReference, "volume '%s' not defined", volume)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateServiceResources(sResources map[string]model.HostResTarget, mResources map[string]model.HostResource) error {
+	var errs issues
 	if len(sResources) > 0 && len(mResources) == 0 {
-		return errors.New("no resources defined")
+		errs.addf("", CodeUndefinedReference, "no resources defined")
+		return errs.err()
 	}
-	for _, target := range sResources {
+	for mntPt, target := range sResources {
 		if _, ok := mResources[target.Ref]; !ok {
-			return fmt.Errorf("resource '%s' not defined", target.Ref)
+			errs.addf(mntPt, CodeUndefinedReference, "resource '%s' not defined", target.Ref)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateServiceSecrets(sSecretMounts, sSecretVars map[string]model.SecretTarget, mSecrets map[string]model.Secret) error {
+	var errs issues
 	if len(sSecretMounts)+len(sSecretVars) > 0 && len(mSecrets) == 0 {
-		return errors.New("no secrets defined")
+		errs.addf("", CodeUndefinedReference, "no secrets defined")
+		return errs.err()
 	}
-	for _, target := range sSecretMounts {
+	for mntPt, target := range sSecretMounts {
 		if _, ok := mSecrets[target.Ref]; !ok {
-			return fmt.Errorf("secret '%s' not defined", target.Ref)
+			errs.addf(joinPath("secret_mounts", mntPt), CodeUndefinedReference, "secret '%s' not defined", target.Ref)
 		}
 	}
-	for _, target := range sSecretVars {
+	for refVar, target := range sSecretVars {
 		if _, ok := mSecrets[target.Ref]; !ok {
-			return fmt.Errorf("secret '%s' not defined", target.Ref)
+			errs.addf(joinPath("secret_vars", refVar), CodeUndefinedReference, "secret '%s' not defined", target.Ref)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateServiceConfigs(sConfigs map[string]string, mConfigs model.Configs) error {
+	var errs issues
 	if len(sConfigs) > 0 && len(mConfigs) == 0 {
-		return errors.New("no configs defined")
+		errs.addf("", CodeUndefinedReference, "no configs defined")
+		return errs.err()
 	}
-	for _, confRef := range sConfigs {
+	for refVar, confRef := range sConfigs {
 		if _, ok := mConfigs[confRef]; !ok {
-			return fmt.Errorf("config '%s' not defined", confRef)
+			errs.addf(refVar, CodeUndefinedReference, "config '%s' not defined", confRef)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateServiceFiles(sFiles map[string]string, mFiles map[string]model.File) error {
+	var errs issues
 	if len(sFiles) > 0 && len(mFiles) == 0 {
-		return errors.New("no files defined")
+		errs.addf("", CodeUndefinedReference, "no files defined")
+		return errs.err()
 	}
-	for _, ref := range sFiles {
+	for mntPt, ref := range sFiles {
 		if _, ok := mFiles[ref]; !ok {
-			return fmt.Errorf("file '%s' not defined", ref)
+			errs.addf(mntPt, CodeUndefinedReference, "file '%s' not defined", ref)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateServiceFileGroups(sFileGroups map[string]string, mFileGroups map[string]struct{}) error {
+	var errs issues
 	if len(sFileGroups) > 0 && len(mFileGroups) == 0 {
-		return errors.New("no file groups defined")
+		errs.addf("", CodeUndefinedReference, "no file groups defined")
+		return errs.err()
 	}
-	for _, ref := range sFileGroups {
+	for basePath, ref := range sFileGroups {
 		if _, ok := mFileGroups[ref]; !ok {
-			return fmt.Errorf("file group '%s' not defined", ref)
+			errs.addf(basePath, CodeUndefinedReference, "file group '%s' not defined", ref)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateServiceHttpEndpoints(sHttpEndpoints map[string]model.HttpEndpoint, extPaths map[string]struct{}) error {
+	var errs issues
 	for extPath, ept := range sHttpEndpoints {
+		n := len(errs)
 		if !isValidExtPath(extPath) {
-			return fmt.Errorf("invalid external path '%s'", extPath)
+			errs.addf(extPath, CodeInvalidFormat, "invalid external path '%s'", extPath)
 		}
 		if ept.Path != "" && !isValidPath(ept.Path) {
-			return fmt.Errorf("invalid internal path '%s'", ept.Path)
+			errs.addf(joinPath(extPath, "path"), CodeInvalidFormat, "invalid internal path '%s'", ept.Path)
 		}
 		if _, ok := extPaths[extPath]; ok {
-			return fmt.Errorf("duplicate path '%s'", extPath)
+			errs.addf(extPath, CodeDuplicate, "duplicate path '%s'", extPath)
 		}
 		mt := make(map[string]struct{})
 		for _, t := range ept.StringSub.MimeTypes {
 			if _, ok := mt[t]; ok {
-				return fmt.Errorf("duplicate mime type '%s'", t)
+				errs.addf(joinPath(extPath, "string_sub.mime_types"), CodeDuplicate, "duplicate mime type '%s'", t)
 			}
 			mt[t] = struct{}{}
 		}
-		extPaths[extPath] = struct{}{}
+		if len(errs) == n {
+			extPaths[extPath] = struct{}{}
+		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateServiceExternalDependencies(sExtDependencies map[string]model.ExtDependencyTarget, mDependencies map[string]string) error {
+	var errs issues
 	if len(sExtDependencies) > 0 && len(mDependencies) == 0 {
-		return errors.New("no module dependencies defined")
+		errs.addf("", CodeUndefinedReference, "no module dependencies defined")
+		return errs.err()
 	}
-	for _, target := range sExtDependencies {
+	for refVar, target := range sExtDependencies {
 		if target.Service == "" {
-			return errors.New("empty service reference")
+			errs.addf(refVar, CodeEmptyReference, "empty service reference")
 		}
 		if _, ok := mDependencies[target.ID]; !ok {
-			return fmt.Errorf("module dependency '%s' not defined", target.ID)
+			errs.addf(refVar, CodeUndefinedReference, "module dependency '%s' not defined", target.ID)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func validateServiceReferences(sReferences map[string]model.SrvRefTarget, mServices map[string]model.Service) error {
+	var errs issues
 	if len(sReferences) > 0 && len(mServices) == 0 {
-		return errors.New("no services defined")
+		errs.addf("", CodeUndefinedReference, "no services defined")
+		return errs.err()
 	}
-	for _, target := range sReferences {
+	for refVar, target := range sReferences {
 		if _, ok := mServices[target.Ref]; !ok {
-			return fmt.Errorf("service '%s' not defined", target.Ref)
+			errs.addf(refVar, CodeUndefinedReference, "service '%s' not defined", target.Ref)
+		}
+	}
+	return errs.err()
+}
+
+func validateServiceOrder(mServices map[string]model.Service) error {
+	var errs issues
+	if _, err := srv_order.GetOrder(mServices, nil); err != nil {
+		var cErr *tsort.CycleError
+		if !errors.As(err, &cErr) {
+			errs.addf("", CodeInvalidValue, "%s", err)
+			return errs.err()
+		}
+		for _, cycle := range cErr.Cycles {
+			errs.addf(joinPath(cycle[0], "srv_references"), CodeReferenceCycle, "reference cycle '%s'", strings.Join(cycle, " -> "))
+		}
+	}
+	return errs.err()
+}
+
+func validateServiceImages(mServices map[string]model.Service, mArchs map[string]struct{}, strict bool) error {
+	var errs issues
+	modArchs := make(map[model.CPUArch]struct{})
+	for arch := range mArchs {
+		if a, err := model.NormalizeCPUArch(arch); err == nil {
+			modArchs[a] = struct{}{}
+		}
+	}
+	for ref, service := range mServices {
+		if imgRef, err := img_ref.Parse(service.Image); err == nil {
+			validateImagePinned(&errs, joinPath(ref, "image"), imgRef, strict)
+		}
+		archs := make(map[model.CPUArch]string)
+		for _, arch := range sortedKeys(service.ArchImages) {
+			img := service.ArchImages[arch]
+			path := joinPath(ref, "arch_images."+arch)
+			a, err := model.NormalizeCPUArch(arch)
+			if err != nil {
+				errs.addf(path, CodeInvalidValue, "%s", err)
+				continue
+			}
+			if imgRef, err := img_ref.Parse(img); err != nil {
+				errs.addf(path, CodeInvalidFormat, "%s", err)
+			} else {
+				validateImagePinned(&errs, path, imgRef, strict)
+			}
+			if k, ok := archs[a]; ok {
+				errs.addf(path, CodeDuplicate, "duplicate architecture '%s' and '%s'", k, arch)
+			}
+			archs[a] = arch
+			if _, ok := modArchs[a]; !ok && len(mArchs) > 0 {
+				errs.addf(path, CodeUndefinedReference, "architecture '%s' not defined", arch)
+			}
+		}
+		if service.Image != "" || len(service.ArchImages) == 0 {
+			continue
+		}
+		if len(mArchs) == 0 {
+			errs.addf(joinPath(ref, "image"), CodeUndefinedReference, "no image for architectures without arch image")
+			continue
+		}
+		for _, arch := range sortedKeys(mArchs) {
+			if _, err := service.GetImage(arch); err != nil {
+				errs.addf(joinPath(ref, "image"), CodeUndefinedReference, "%s", err)
+			}
 		}
 	}
-	return nil
+	return errs.err()
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// validateImagePinned adds an error in strict mode and a warning otherwise if the image is not pinned.
+func validateImagePinned(errs *issues, path string, imgRef img_ref.Reference, strict bool) {
+	if imgRef.Pinned() {
+		return
+	}
+	if strict {
+		errs.addf(path, CodeUnpinnedImage, "image '%s' not pinned by digest or tag", imgRef)
+		return
+	}
+	errs.warnf(path, CodeUnpinnedImage, "image '%s' not pinned by digest or tag", imgRef)
 }
 
 func genPortKey(n int, p model.PortProtocol) string {
@@ -281,23 +342,28 @@ func genPortKey(n int, p model.PortProtocol) string {
 }
 
 func validateServicePorts(sPorts []model.Port, hostPorts map[string]struct{}) error {
+	var errs issues
 	expPorts := make(map[string]struct{})
-	for _, port := range sPorts {
+	for i, port := range sPorts {
+		path := strconv.Itoa(i)
 		if _, ok := model.PortProtocolMap[port.Protocol]; !ok {
-			return fmt.Errorf("invalid protocol '%s'", port.Protocol)
+			errs.addf(joinPath(path, "protocol"), CodeInvalidValue, "invalid protocol '%s'", port.Protocol)
+			continue
 		}
 		pKey := genPortKey(port.Number, port.Protocol)
 		if _, ok := expPorts[pKey]; ok {
-			return fmt.Errorf("duplicate port '%d/%s'", port.Number, port.Protocol)
+			errs.addf(path, CodeDuplicate, "duplicate port '%d/%s'", port.Number, port.Protocol)
+			continue
 		}
 		expPorts[pKey] = struct{}{}
 		for _, binding := range port.Bindings {
 			bpKey := genPortKey(binding, port.Protocol)
 			if _, ok := hostPorts[bpKey]; ok {
-				return fmt.Errorf("duplicate port binding '%d/%s'", binding, port.Protocol)
+				errs.addf(joinPath(path, "bindings"), CodeDuplicate, "duplicate port binding '%d/%s'", binding, port.Protocol)
+				continue
 			}
 			hostPorts[bpKey] = struct{}{}
 		}
 	}
-	return nil
+	return errs.err()
 }
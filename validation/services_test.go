@@ -24,12 +24,20 @@ import (
 
 func TestValidateServices(t *testing.T) {
 	s := map[string]model.Service{
-		"a": {},
+		"a": {Image: "test:v1"},
+		"b": {ArchImages: map[string]string{model.AMD64: "test:v1"}},
 	}
 	if err := validateServices(s, nil, nil, nil, nil, nil, nil, nil); err != nil {
 		t.Error("err != nil")
 	}
 	// ------------------------------
+	s = map[string]model.Service{
+		"a": {},
+	}
+	if err := validateServices(s, nil, nil, nil, nil, nil, nil, nil); err == nil {
+		t.Error("err == nil")
+	}
+	// ------------------------------
 	s = map[string]model.Service{
 		"": {},
 	}
@@ -37,6 +45,13 @@ func TestValidateServices(t *testing.T) {
 		t.Error("err == nil")
 	}
 	// ------------------------------
+	s = map[string]model.Service{
+		"a": {Image: "nginx:latest:1"},
+	}
+	if err := validateServices(s, nil, nil, nil, nil, nil, nil, nil); err == nil {
+		t.Error("err == nil")
+	}
+	// ------------------------------
 	s = map[string]model.Service{
 		"a": {
 			BindMounts: map[string]model.BindMount{"": {}},
@@ -663,3 +678,115 @@ func TestValidateServicePorts(t *testing.T) {
 		t.Error("len(hostPorts) != 1")
 	}
 }
+
+func TestValidateServiceOrder(t *testing.T) {
+	var mServices map[string]model.Service
+	if err := validateServiceOrder(mServices); err != nil {
+		t.Error("err != nil")
+	}
+	mServices = map[string]model.Service{
+		"a": {SrvReferences: map[string]model.SrvRefTarget{"B": {Ref: "b"}}},
+		"b": {},
+	}
+	if err := validateServiceOrder(mServices); err != nil {
+		t.Error("err != nil")
+	}
+	mServices["b"] = model.Service{SrvReferences: map[string]model.SrvRefTarget{"A": {Ref: "a"}}}
+	if err := validateServiceOrder(mServices); err == nil {
+		t.Error("err == nil")
+	} else if err.Error() != "a.srv_references: reference cycle 'a -> b -> a'" {
+		t.Errorf("\"%s\" != \"a.srv_references: reference cycle 'a -> b -> a'\"", err)
+	}
+	mServices = map[string]model.Service{
+		"a": {SrvReferences: map[string]model.SrvRefTarget{"A": {Ref: "a"}}},
+	}
+	if err := validateServiceOrder(mServices); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestValidateServiceImages(t *testing.T) {
+	mArchs := map[string]struct{}{model.AMD64: {}, model.ARM32V7: {}}
+	mServices := map[string]model.Service{
+		"a": {Image: "test:v1"},
+		"b": {ArchImages: map[string]string{model.AMD64: "test:v1", model.ARM32V7: "test:v1"}},
+		"c": {Image: "test:v1", ArchImages: map[string]string{model.ARM32V7: "test:v1"}},
+	}
+	if err := validateServiceImages(mServices, mArchs, false); err != nil {
+		t.Error("err != nil")
+	}
+	if err := validateServiceImages(mServices, nil, false); err == nil {
+		t.Error("err == nil")
+	} else if err.Error() != "b.image: no image for architectures without arch image" {
+		t.Errorf("\"%s\" != \"b.image: no image for architectures without arch image\"", err)
+	}
+	delete(mServices, "b")
+	if err := validateServiceImages(mServices, nil, false); err != nil {
+		t.Error("err != nil")
+	}
+	mServices = map[string]model.Service{
+		"a": {ArchImages: map[string]string{model.AMD64: "test:v1"}},
+	}
+	if err := validateServiceImages(mServices, map[string]struct{}{model.X86_64: {}}, false); err != nil {
+		t.Error(err)
+	}
+	if err := validateServiceImages(mServices, mArchs, false); err == nil {
+		t.Error("err == nil")
+	} else if err.Error() != "a.image: no image for architecture 'arm32v7'" {
+		t.Errorf("\"%s\" != \"a.image: no image for architecture 'arm32v7'\"", err)
+	}
+	mServices = map[string]model.Service{
+		"a": {ArchImages: map[string]string{model.I386: "test:v1"}},
+	}
+	mArchs = map[string]struct{}{model.AMD64: {}, model.ARM32V7: {}, model.ARM64V8: {}, model.I386: {}}
+	for i := 0; i < 10; i++ {
+		if err := validateServiceImages(mServices, mArchs, false); err == nil {
+			t.Error("err == nil")
+		} else if err.Error() != "a.image: no image for architecture 'arm32v7'; a.image: no image for architecture 'arm64v8'" {
+			t.Errorf("\"%s\" != \"a.image: no image for architecture 'arm32v7'; a.image: no image for architecture 'arm64v8'\"", err)
+		}
+	}
+	mArchs = map[string]struct{}{model.AMD64: {}, model.ARM32V7: {}}
+	mServices = map[string]model.Service{
+		"a": {Image: "test:v1", ArchImages: map[string]string{"test": "test:v1"}},
+	}
+	if err := validateServiceImages(mServices, nil, false); err == nil {
+		t.Error("err == nil")
+	}
+	mServices = map[string]model.Service{
+		"a": {Image: "test:v1", ArchImages: map[string]string{model.AMD64: ""}},
+	}
+	if err := validateServiceImages(mServices, nil, false); err == nil {
+		t.Error("err == nil")
+	}
+	mServices = map[string]model.Service{
+		"a": {Image: "test:v1", ArchImages: map[string]string{model.AMD64: "test:v1", model.X86_64: "test:v1"}},
+	}
+	if err := validateServiceImages(mServices, nil, false); err == nil {
+		t.Error("err == nil")
+	}
+	mServices = map[string]model.Service{
+		"a": {Image: "test:v1", ArchImages: map[string]string{model.I386: "test:v1"}},
+	}
+	if err := validateServiceImages(mServices, mArchs, false); err == nil {
+		t.Error("err == nil")
+	}
+	mServices = map[string]model.Service{
+		"a": {Image: "test"},
+	}
+	if err := validateServiceImages(mServices, nil, true); err == nil {
+		t.Error("err == nil")
+	}
+	mServices = map[string]model.Service{
+		"a": {Image: "test:v1", ArchImages: map[string]string{model.AMD64: "test:latest"}},
+	}
+	if err := validateServiceImages(mServices, nil, true); err == nil {
+		t.Error("err == nil")
+	}
+	mServices = map[string]model.Service{
+		"a": {Image: "test:v1", ArchImages: map[string]string{model.AMD64: "Test"}},
+	}
+	if err := validateServiceImages(mServices, nil, false); err == nil {
+		t.Error("err == nil")
+	}
+}
@@ -17,8 +17,6 @@
 package validation
 
 import (
-	"errors"
-	"fmt"
 	"regexp"
 )
 
@@ -32,16 +30,19 @@ func validateKeyNotEmptyString[T any](m map[string]T) bool {
 }
 
 func validateMapKeys[T any](m map[string]T, keys map[string]struct{}) error {
+	var errs issues
 	for k := range m {
 		if k == "" {
-			return errors.New("empty")
+			errs.addf("", CodeEmptyReference, "empty")
+			continue
 		}
 		if _, ok := keys[k]; ok {
-			return fmt.Errorf("duplicate '%s'", k)
+			errs.addf(k, CodeDuplicate, "duplicate '%s'", k)
+			continue
 		}
 		keys[k] = struct{}{}
 	}
-	return nil
+	return errs.err()
 }
 
 func isValidPath(s string) bool {